@@ -0,0 +1,67 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Acknowledgment workflow: delivered messages carry a short ID and instruct
+// the receiver to run `msg --ack <id>` once handled. `msg --pending` lists
+// unacked messages and flags those past the escalation timeout so a human
+// (or a wrapper script) can re-send or intervene.
+
+// ackEscalateAfter is how long a message may sit unacked before it is
+// flagged as overdue by `msg --pending`
+const ackEscalateAfter = 5 * time.Minute
+
+// newAckID returns a short random ID carried in delivered messages
+func newAckID() string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+// runAck handles `msg --ack <id>`, marking a delivered message as handled
+func runAck(args []string) {
+	if len(args) < 1 {
+		fmt.Fprintf(os.Stderr, "Usage: msg --ack <id>\n")
+		os.Exit(1)
+	}
+
+	if err := MarkAcked(args[0]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Acknowledged %s\n", args[0])
+}
+
+// runPending handles `msg --pending`, listing unacked messages and flagging
+// those past the escalation timeout
+func runPending() {
+	pending, err := PendingAcks()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(pending) == 0 {
+		fmt.Println("No messages awaiting acknowledgment")
+		return
+	}
+
+	fmt.Println("=== PENDING ACKNOWLEDGMENTS ===")
+	for _, p := range pending {
+		age := time.Since(p.SentAt).Round(time.Second)
+		flag := ""
+		if age > ackEscalateAfter {
+			flag = "  ⚠ OVERDUE - consider re-sending"
+		}
+		fmt.Printf("[%s] %s → %s (%s ago): %s%s\n",
+			p.AckID, p.Sender, p.Receiver, age, p.Message, flag)
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Optional broker-based transport (NATS) as an alternative to SSH fan-out:
+// every agent has its own subject (slaygent.agent.<name>), `msg --listen`
+// subscribes for all locally registered agents and injects deliveries into
+// their panes, and msg publishes when a target isn't registered locally.
+
+// brokerConfig is read from ~/.slaygent/broker.json
+type brokerConfig struct {
+	URL string `json:"url"` // e.g. nats://broker.example.com:4222
+}
+
+// brokerMessage is the payload published to an agent's subject
+type brokerMessage struct {
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Message  string `json:"message"`
+}
+
+// agentSubject returns the broker subject an agent listens on
+func agentSubject(name string) string {
+	return "slaygent.agent." + name
+}
+
+// loadBrokerConfig returns the broker configuration, or nil when no broker is
+// set up. SLAYGENT_BROKER overrides the config file.
+func loadBrokerConfig() *brokerConfig {
+	if url := os.Getenv("SLAYGENT_BROKER"); url != "" {
+		return &brokerConfig{URL: url}
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(home, ".slaygent", "broker.json"))
+	if err != nil {
+		return nil // No broker configured
+	}
+
+	var cfg brokerConfig
+	if err := json.Unmarshal(data, &cfg); err != nil || cfg.URL == "" {
+		return nil
+	}
+	return &cfg
+}
+
+// publishToBroker sends a message to the receiver's subject
+func publishToBroker(cfg *brokerConfig, sender, receiver, message string) error {
+	conn, err := nats.Connect(cfg.URL)
+	if err != nil {
+		return fmt.Errorf("failed to connect to broker %s: %w", cfg.URL, err)
+	}
+	defer conn.Close()
+
+	payload, err := json.Marshal(brokerMessage{
+		Sender:   sender,
+		Receiver: receiver,
+		Message:  message,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := conn.Publish(agentSubject(receiver), payload); err != nil {
+		return err
+	}
+	return conn.Flush()
+}
+
+// runBrokerListener subscribes to the subjects of all locally registered
+// agents and injects incoming messages into their panes. Blocks until killed.
+func runBrokerListener() {
+	cfg := loadBrokerConfig()
+	if cfg == nil {
+		fmt.Fprintf(os.Stderr, "Error: no broker configured (set SLAYGENT_BROKER or ~/.slaygent/broker.json)\n")
+		os.Exit(1)
+	}
+
+	registry := loadRegistry()
+	if registry == nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to load registry\n")
+		os.Exit(1)
+	}
+
+	conn, err := nats.Connect(cfg.URL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to connect to broker %s: %v\n", cfg.URL, err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	subscribed := 0
+	for _, agent := range registry {
+		if agent.Machine != "host" && agent.Machine != "" {
+			continue // Only deliver for agents on this machine
+		}
+
+		agent := agent // Capture for the handler closure
+		_, err := conn.Subscribe(agentSubject(agent.Name), func(m *nats.Msg) {
+			deliverBrokerMessage(&agent, m.Data, registry)
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to subscribe for %s: %v\n", agent.Name, err)
+			continue
+		}
+		subscribed++
+		fmt.Printf("Listening for %s on %s\n", agent.Name, agentSubject(agent.Name))
+	}
+
+	if subscribed == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no local agents to listen for\n")
+		os.Exit(1)
+	}
+
+	runtime.Goexit() // Block forever while handlers run
+}
+
+// deliverBrokerMessage injects a broker delivery into the receiving agent's pane
+func deliverBrokerMessage(agent *RegistryEntry, payload []byte, registry []RegistryEntry) {
+	var msg brokerMessage
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		return
+	}
+
+	// On-receive hook may veto or transform incoming broker deliveries
+	if script := loadConfig().Hooks.OnReceive; script != "" {
+		transformed, ok := runHook("on-receive", script, msg.Sender, agent.Name, msg.Message)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Warning: delivery to %s blocked by on-receive hook\n", agent.Name)
+			return
+		}
+		msg.Message = transformed
+	}
+
+	pane := findAgentPane(agent)
+	if pane == nil {
+		pane = findTerminalPane(agent)
+	}
+	if pane == nil {
+		fmt.Fprintf(os.Stderr, "Warning: %s not found for broker delivery\n", agent.Name)
+		return
+	}
+
+	formatted := fmt.Sprintf(
+		"{Receiving msg from: %s} \"%s\" {When ready to respond use: msg --from %s %s 'your return message'}",
+		msg.Sender, msg.Message, agent.Name, msg.Sender)
+
+	if isTerminalPane(pane.ID) {
+		sendTerminalMessage(pane.ID, formatted)
+	} else {
+		injectTmuxMessage(pane.ID, formatted)
+	}
+
+	if msg.Sender != "" {
+		LogMessage(msg.Sender, "remote", agent.Name, agent.Directory, msg.Message)
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Optional compression of large message bodies before they are stored in
+// messages.db, for agents that trade multi-KB outputs dozens of times an
+// hour. Compressed bodies are base64-encoded in the TEXT column with the
+// `compressed` flag naming the algorithm; delivery always carries the plain
+// text. Enabled with compress_messages: true in ~/.slaygent/config.yaml.
+
+// compressThreshold is the minimum body size worth compressing
+const compressThreshold = 2048
+
+// Values of the `compressed` column: the flag names the algorithm so a
+// future change doesn't require rewriting stored rows
+const (
+	compressedNone = 0
+	compressedGzip = 1 // written by older versions, still readable
+	compressedZstd = 2
+)
+
+// maybeCompress returns the body to store and its compressed flag
+func maybeCompress(message string) (string, int) {
+	if !loadConfig().CompressMessages || len(message) < compressThreshold {
+		return message, compressedNone
+	}
+
+	var buf bytes.Buffer
+	writer, err := zstd.NewWriter(&buf)
+	if err != nil {
+		return message, compressedNone
+	}
+	if _, err := writer.Write([]byte(message)); err != nil {
+		writer.Close()
+		return message, compressedNone
+	}
+	if err := writer.Close(); err != nil {
+		return message, compressedNone
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), compressedZstd
+}
+
+// decompressBody reverses maybeCompress, returning the stored value
+// unchanged when it is not flagged or cannot be decoded
+func decompressBody(message string, compressed int) string {
+	if compressed == compressedNone {
+		return message
+	}
+
+	data, err := base64.StdEncoding.DecodeString(message)
+	if err != nil {
+		return message
+	}
+
+	switch compressed {
+	case compressedGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return message
+		}
+		defer reader.Close()
+		out, err := io.ReadAll(reader)
+		if err != nil {
+			return message
+		}
+		return string(out)
+	case compressedZstd:
+		reader, err := zstd.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return message
+		}
+		defer reader.Close()
+		out, err := io.ReadAll(reader)
+		if err != nil {
+			return message
+		}
+		return string(out)
+	}
+	return message
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// User configuration from ~/.slaygent/config.yaml, the file shared by slay,
+// msg and msg-ssh - each binary parses just the keys it needs. msg reads
+// "retention_days": how long message history is kept before startup cleanup
+// deletes it (default 30).
+
+type msgConfig struct {
+	RetentionDays     int               `yaml:"retention_days,omitempty"`
+	QuickReplies      []quickReply      `yaml:"quick_replies,omitempty"`
+	Templates         []messageTemplate `yaml:"templates,omitempty"`
+	TypewriterChunk   int               `yaml:"typewriter_chunk,omitempty"`    // characters per chunk
+	TypewriterDelayMs int               `yaml:"typewriter_delay_ms,omitempty"` // pause between chunks
+	HumanName         string            `yaml:"human_name,omitempty"`          // sender name for --as-human
+	Hooks             msgHooks          `yaml:"hooks,omitempty"`
+	RedactSecrets     bool              `yaml:"redact_secrets,omitempty"`    // mask likely secrets before logging
+	RedactAllowlist   []string          `yaml:"redact_allowlist,omitempty"`  // substrings exempt from redaction
+	CompressMessages  bool              `yaml:"compress_messages,omitempty"` // gzip large bodies in the DB
+	DetachedInbox     bool              `yaml:"detached_inbox,omitempty"`    // also inbox messages sent to detached sessions
+	Notify            bool              `yaml:"notify,omitempty"`            // desktop notification per delivered message
+	Policy            msgPolicy         `yaml:"policy,omitempty"`            // length/content/recipient limits (policy.go)
+}
+
+// loadConfig reads the shared config file, returning defaults when absent
+// or unparsable
+func loadConfig() msgConfig {
+	defaults := msgConfig{RetentionDays: 30, TypewriterChunk: 40, TypewriterDelayMs: 50, HumanName: "me"}
+	config := defaults
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return config
+	}
+
+	data, err := os.ReadFile(filepath.Join(home, ".slaygent", "config.yaml"))
+	if err != nil {
+		return config
+	}
+
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return defaults
+	}
+	if config.RetentionDays <= 0 {
+		config.RetentionDays = defaults.RetentionDays
+	}
+	if config.TypewriterChunk <= 0 {
+		config.TypewriterChunk = defaults.TypewriterChunk
+	}
+	if config.TypewriterDelayMs <= 0 {
+		config.TypewriterDelayMs = defaults.TypewriterDelayMs
+	}
+	if config.HumanName == "" {
+		config.HumanName = defaults.HumanName
+	}
+	return config
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// Context window assembler: `msg --context <agent> [--max-tokens N]` prints
+// a token-budgeted slice of the recent conversation with that agent so an
+// agent can cheaply rehydrate context. The budget is filled newest-first,
+// then printed chronologically; the oldest included message is truncated
+// when it only partially fits.
+
+// tokenEstimate approximates the token count of a string (~4 chars/token,
+// the usual rule of thumb for English and code)
+func tokenEstimate(s string) int {
+	return (len(s) + 3) / 4
+}
+
+// runContext handles `msg --context <agent> [--max-tokens N]`
+func runContext(args []string) {
+	var other string
+	maxTokens := 2000
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--max-tokens":
+			if i+1 >= len(args) {
+				fmt.Fprintln(os.Stderr, "Error: --max-tokens requires a number")
+				os.Exit(1)
+			}
+			i++
+			n, err := strconv.Atoi(args[i])
+			if err != nil || n <= 0 {
+				fmt.Fprintf(os.Stderr, "Error: invalid token budget %q\n", args[i])
+				os.Exit(1)
+			}
+			maxTokens = n
+		default:
+			if other != "" {
+				fmt.Fprintln(os.Stderr, "Usage: msg --context <agent_name> [--max-tokens N]")
+				os.Exit(1)
+			}
+			other = args[i]
+		}
+	}
+	if other == "" {
+		fmt.Fprintln(os.Stderr, "Usage: msg --context <agent_name> [--max-tokens N]")
+		os.Exit(1)
+	}
+
+	sender := detectSenderFromRegistry(loadRegistry())
+	if sender == "" || sender == "unknown" {
+		fmt.Fprintln(os.Stderr, "Error: could not determine sender (run from a registered agent's directory)")
+		os.Exit(1)
+	}
+
+	lines, err := recentConversationLines(sender, other, 200)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if len(lines) == 0 {
+		fmt.Printf("No conversation found between %s and %s\n", sender, other)
+		return
+	}
+
+	// Fill the budget newest-first (lines arrive newest first)
+	var selected []string
+	remaining := maxTokens
+	for _, line := range lines {
+		cost := tokenEstimate(line)
+		if cost <= remaining {
+			selected = append(selected, line)
+			remaining -= cost
+			continue
+		}
+		// Partial fit: truncate so the oldest included message still
+		// carries its head, then stop - width-aware so a multi-byte rune
+		// is never split mid-sequence
+		if remaining > 20 {
+			keep := remaining * 4
+			if keep < len(line) {
+				selected = append(selected, runewidth.Truncate(line, keep, "..."))
+			}
+		}
+		break
+	}
+
+	// Print chronologically
+	fmt.Printf("Context: %s ↔ %s (~%d of %d tokens)\n", sender, other, maxTokens-remaining, maxTokens)
+	for i := len(selected) - 1; i >= 0; i-- {
+		fmt.Println(selected[i])
+	}
+}
+
+// recentConversationLines returns formatted message lines between two
+// agents, newest first
+func recentConversationLines(agent1Name, agent2Name string, limit int) ([]string, error) {
+	if db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	names := []string{agent1Name, agent2Name}
+	sort.Strings(names)
+
+	rows, err := db.Query(`
+		SELECT m.sender_name, m.message, datetime(m.sent_at, 'localtime'), COALESCE(m.compressed, 0)
+		FROM messages m
+		JOIN conversations c ON m.conversation_id = c.id
+		WHERE c.agent1_name = ? AND c.agent2_name = ?
+		ORDER BY m.sent_at DESC
+		LIMIT ?`,
+		names[0], names[1], limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lines []string
+	for rows.Next() {
+		var sender, message, sentAt string
+		var compressed int
+		if err := rows.Scan(&sender, &message, &sentAt, &compressed); err != nil {
+			return nil, err
+		}
+		body := strings.ReplaceAll(decompressBody(message, compressed), "\n", " ")
+		lines = append(lines, fmt.Sprintf("[%s] %s: %s", sentAt, sender, body))
+	}
+	return lines, rows.Err()
+}
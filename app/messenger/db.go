@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,7 +26,8 @@ func InitDB() error {
 
 	dbPath := filepath.Join(slaygentDir, "messages.db")
 
-	db, err = sql.Open("sqlite3", dbPath)
+	// Let SQLite wait briefly on its own before we surface lock errors
+	db, err = sql.Open("sqlite3", dbPath+"?_busy_timeout=2000")
 	if err != nil {
 		return err
 	}
@@ -63,9 +65,49 @@ func InitDB() error {
 		return err
 	}
 
+	// Ack workflow columns - ALTER fails harmlessly once they exist
+	db.Exec(`ALTER TABLE messages ADD COLUMN ack_id TEXT`)
+	db.Exec(`ALTER TABLE messages ADD COLUMN acked_at TIMESTAMP`)
+
+	// Delivery status for `msg --sent` (NULL means sent)
+	db.Exec(`ALTER TABLE messages ADD COLUMN status TEXT`)
+
+	// Compression flag - 1 when the body is stored gzip+base64
+	db.Exec(`ALTER TABLE messages ADD COLUMN compressed INTEGER DEFAULT 0`)
+
+	// Parent message for threading, set via --reply-to (NULL means top-level)
+	db.Exec(`ALTER TABLE messages ADD COLUMN reply_to INTEGER`)
+
+	// Conversation status badge set via `msg --set-status`
+	db.Exec(`ALTER TABLE conversations ADD COLUMN status TEXT`)
+
+	// Reply expectations recorded by --expect-reply, checked by the watchdog
+	db.Exec(`
+	CREATE TABLE IF NOT EXISTS expected_replies (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		expecter TEXT NOT NULL,
+		replier TEXT NOT NULL,
+		deadline TIMESTAMP NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		fulfilled_at TIMESTAMP,
+		escalated_at TIMESTAMP
+	)`)
+
+	// Audit log shared with the TUI Activity view and `slay events`
+	db.Exec(`
+	CREATE TABLE IF NOT EXISTS events (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		event_type TEXT NOT NULL,
+		detail TEXT NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`)
+
 	// Run cleanup on startup
 	CleanupOldMessages()
 
+	// Flush any log entries queued while the database was locked
+	replayJournal()
+
 	return nil
 }
 
@@ -131,14 +173,75 @@ func LogMessage(sender, senderDir, receiver, receiverDir, message string) error
 		return fmt.Errorf("database not initialized")
 	}
 
+	entry := journalEntry{
+		Sender:      sender,
+		SenderDir:   senderDir,
+		Receiver:    receiver,
+		ReceiverDir: receiverDir,
+		AckID:   pendingAckID,
+		ReplyTo: pendingReplyTo,
+		Status:  pendingStatus,
+		SentAt:  time.Now().UTC().Format("2006-01-02 15:04:05"),
+	}
+	entry.Message, entry.Compressed = maybeCompress(redactSecrets(message))
+	pendingAckID = ""
+	pendingReplyTo = ""
+	pendingStatus = ""
+	if err := logEntryWithRetry(entry); err != nil {
+		return err
+	}
+	notifyDelivery(sender, receiver, message)
+	return nil
+}
+
+// LogFailedMessage records a dispatch that never reached its target so
+// `msg --sent --failed` can report it later
+func LogFailedMessage(sender, senderDir, receiver, receiverDir, message string) error {
+	if db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	entry := journalEntry{
+		Sender:      sender,
+		SenderDir:   senderDir,
+		Receiver:    receiver,
+		ReceiverDir: receiverDir,
+		Status: "failed",
+		SentAt: time.Now().UTC().Format("2006-01-02 15:04:05"),
+	}
+	entry.Message, entry.Compressed = maybeCompress(redactSecrets(message))
+	return logEntryWithRetry(entry)
+}
+
+// logEntryWithRetry writes a log entry, retrying with backoff when a
+// concurrent msg process holds the lock and journaling on persistent failure
+func logEntryWithRetry(entry journalEntry) error {
+	var err error
+	for attempt := 0; attempt < 5; attempt++ {
+		if err = logMessageEntry(entry); err == nil || !isLockedErr(err) {
+			return err
+		}
+		time.Sleep(time.Duration(50<<attempt) * time.Millisecond)
+	}
+
+	// Persistently locked - queue the entry so a later run can replay it
+	if jErr := queueJournalEntry(entry); jErr != nil {
+		return fmt.Errorf("%v (journal write also failed: %v)", err, jErr)
+	}
+	fmt.Fprintf(os.Stderr, "Warning: database locked, message log queued for replay\n")
+	return nil
+}
+
+// logMessageEntry writes one log entry; the caller handles lock retries
+func logMessageEntry(e journalEntry) error {
 	// Create registry entries for conversation lookup
 	senderEntry := &RegistryEntry{
-		Name:      sender,
-		Directory: senderDir,
+		Name:      e.Sender,
+		Directory: e.SenderDir,
 	}
 	receiverEntry := &RegistryEntry{
-		Name:      receiver,
-		Directory: receiverDir,
+		Name:      e.Receiver,
+		Directory: e.ReceiverDir,
 	}
 
 	// Get or create conversation
@@ -147,16 +250,221 @@ func LogMessage(sender, senderDir, receiver, receiverDir, message string) error
 		return err
 	}
 
-	// Insert message
+	// Insert message, carrying the ack ID when this delivery requested one.
+	// Journaled entries keep their original send time.
 	_, err = db.Exec(`
-		INSERT INTO messages (conversation_id, sender_name, sender_dir, receiver_name, receiver_dir, message)
-		VALUES (?, ?, ?, ?, ?, ?)`,
-		conversationID, sender, senderDir, receiver, receiverDir, message,
+		INSERT INTO messages (conversation_id, sender_name, sender_dir, receiver_name, receiver_dir, message, ack_id, sent_at, status, compressed, reply_to)
+		VALUES (?, ?, ?, ?, ?, ?, NULLIF(?, ''), COALESCE(NULLIF(?, ''), CURRENT_TIMESTAMP), NULLIF(?, ''), ?, NULLIF(?, 0))`,
+		conversationID, e.Sender, e.SenderDir, e.Receiver, e.ReceiverDir, e.Message, e.AckID, e.SentAt, e.Status, e.Compressed, resolveReplyTo(e.ReplyTo),
 	)
+	if err != nil {
+		return err
+	}
 
+	// A delivered message fulfills any open expectation of a reply from its
+	// sender; failed dispatches never reached anyone
+	if e.Status != "failed" {
+		db.Exec(`
+			UPDATE expected_replies SET fulfilled_at = CURRENT_TIMESTAMP
+			WHERE fulfilled_at IS NULL AND expecter = ? AND replier = ?`,
+			e.Receiver, e.Sender,
+		)
+	}
+
+	return nil
+}
+
+// isLockedErr reports whether an error came from SQLite's lock contention
+func isLockedErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "database is locked") || strings.Contains(msg, "database table is locked")
+}
+
+// ExpectReply records that the sender is waiting on a reply from the
+// receiver within the given duration
+func ExpectReply(expecter, replier string, within time.Duration) error {
+	if db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	deadline := time.Now().Add(within).UTC().Format("2006-01-02 15:04:05")
+	_, err := db.Exec(`
+		INSERT INTO expected_replies (expecter, replier, deadline)
+		VALUES (?, ?, ?)`,
+		expecter, replier, deadline,
+	)
+	return err
+}
+
+// ExpectedReply is one recorded reply expectation
+type ExpectedReply struct {
+	ID       int64
+	Expecter string
+	Replier  string
+	Deadline time.Time
+}
+
+// OverdueReplies returns unfulfilled expectations past their deadline that
+// have not yet been escalated
+func OverdueReplies() ([]ExpectedReply, error) {
+	if db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	rows, err := db.Query(`
+		SELECT id, expecter, replier, deadline
+		FROM expected_replies
+		WHERE fulfilled_at IS NULL AND escalated_at IS NULL
+		AND deadline < CURRENT_TIMESTAMP
+		ORDER BY deadline ASC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var overdue []ExpectedReply
+	for rows.Next() {
+		var exp ExpectedReply
+		if err := rows.Scan(&exp.ID, &exp.Expecter, &exp.Replier, &exp.Deadline); err != nil {
+			return nil, err
+		}
+		overdue = append(overdue, exp)
+	}
+	return overdue, rows.Err()
+}
+
+// MarkEscalated records that the watchdog has acted on an expectation so
+// repeated runs don't re-ping endlessly
+func MarkEscalated(id int64) error {
+	if db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+	_, err := db.Exec(`UPDATE expected_replies SET escalated_at = CURRENT_TIMESTAMP WHERE id = ?`, id)
 	return err
 }
 
+// SetConversationStatus tags the conversation between the two named agents,
+// whichever order they were stored in, returning how many rows matched
+func SetConversationStatus(agentA, agentB, status string) (int64, error) {
+	if db == nil {
+		return 0, fmt.Errorf("database not initialized")
+	}
+
+	result, err := db.Exec(`
+		UPDATE conversations SET status = NULLIF(?, '')
+		WHERE (agent1_name = ? AND agent2_name = ?)
+		   OR (agent1_name = ? AND agent2_name = ?)`,
+		status, agentA, agentB, agentB, agentA)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// pendingAckID is set by the send path before logging so the delivered
+// message row records which ack ID was injected with it
+var pendingAckID string
+
+// pendingReplyTo is the --reply-to handle linking this message to its parent
+var pendingReplyTo string
+
+// resolveReplyTo turns a --reply-to handle into a parent message row id: a
+// numeric handle is used directly, anything else is looked up as an ack ID
+// (the per-message identifier exposed in the delivered wrapper). Unresolvable
+// handles log unthreaded rather than failing the send.
+func resolveReplyTo(handle string) int64 {
+	if handle == "" {
+		return 0
+	}
+	if id, err := strconv.ParseInt(handle, 10, 64); err == nil {
+		return id
+	}
+	var id int64
+	if err := db.QueryRow(`SELECT id FROM messages WHERE ack_id = ?`, handle).Scan(&id); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: --reply-to %s matches no logged message, logging unthreaded\n", handle)
+		return 0
+	}
+	return id
+}
+
+// pendingStatus is set by the send path when delivery carried a caveat worth
+// surfacing later (currently "detached" - keystrokes landed in a detached
+// tmux session)
+var pendingStatus string
+
+// LogEvent appends an action to the shared audit log. Best effort - audit
+// failures never interrupt message delivery.
+func LogEvent(eventType, detail string) {
+	if db == nil {
+		return
+	}
+	db.Exec(`INSERT INTO events (event_type, detail) VALUES (?, ?)`, eventType, detail)
+}
+
+// MarkAcked records an acknowledgment for a delivered message
+func MarkAcked(ackID string) error {
+	if db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	result, err := db.Exec(`
+		UPDATE messages SET acked_at = CURRENT_TIMESTAMP
+		WHERE ack_id = ? AND acked_at IS NULL`,
+		ackID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("no pending message with ack ID '%s'", ackID)
+	}
+	return nil
+}
+
+// PendingAck is an unacknowledged delivered message
+type PendingAck struct {
+	AckID    string
+	Sender   string
+	Receiver string
+	Message  string
+	SentAt   time.Time
+}
+
+// PendingAcks returns delivered messages still awaiting acknowledgment,
+// oldest first
+func PendingAcks() ([]PendingAck, error) {
+	if db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	rows, err := db.Query(`
+		SELECT ack_id, sender_name, receiver_name, message, sent_at, COALESCE(compressed, 0)
+		FROM messages
+		WHERE ack_id IS NOT NULL AND acked_at IS NULL
+		ORDER BY sent_at ASC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pending []PendingAck
+	for rows.Next() {
+		var p PendingAck
+		var compressed int
+		if err := rows.Scan(&p.AckID, &p.Sender, &p.Receiver, &p.Message, &p.SentAt, &compressed); err != nil {
+			return nil, err
+		}
+		p.Message = decompressBody(p.Message, compressed)
+		pending = append(pending, p)
+	}
+	return pending, rows.Err()
+}
+
 func LogMessageFromRegistry(senderInfo string, receiver *RegistryEntry, message string, registry []RegistryEntry) error {
 	// Parse sender info
 	var senderName, senderDir string
@@ -267,18 +575,68 @@ func ConversationExistsWithDirectory(agent1Name, agent1Dir, agent2Name, agent2Di
 	return count > 0
 }
 
+// GetConversationText returns the recent message history between two agents
+// as plain text, newest last. Used by the MCP get_conversation tool.
+func GetConversationText(agent1Name, agent2Name string) (string, error) {
+	if db == nil {
+		return "", fmt.Errorf("database not initialized")
+	}
+
+	// Sort names for consistent lookup
+	names := []string{agent1Name, agent2Name}
+	sort.Strings(names)
+
+	rows, err := db.Query(`
+		SELECT m.sender_name, m.receiver_name, m.message, m.sent_at, COALESCE(m.compressed, 0)
+		FROM messages m
+		JOIN conversations c ON m.conversation_id = c.id
+		WHERE c.agent1_name = ? AND c.agent2_name = ?
+		ORDER BY m.sent_at DESC
+		LIMIT 50`,
+		names[0], names[1],
+	)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var lines []string
+	for rows.Next() {
+		var sender, receiver, message, sentAt string
+		var compressed int
+		if err := rows.Scan(&sender, &receiver, &message, &sentAt, &compressed); err != nil {
+			return "", err
+		}
+		lines = append(lines, fmt.Sprintf("[%s] %s → %s: %s", sentAt, sender, receiver, decompressBody(message, compressed)))
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	if len(lines) == 0 {
+		return fmt.Sprintf("No conversation found between %s and %s", agent1Name, agent2Name), nil
+	}
+
+	// Reverse to chronological order (query returned newest first)
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
 func CleanupOldMessages() error {
 	if db == nil {
 		return fmt.Errorf("database not initialized")
 	}
 
-	// Delete messages older than 30 days
-	thirtyDaysAgo := time.Now().AddDate(0, 0, -30).Format("2006-01-02 15:04:05")
+	// Delete messages older than the configured retention (default 30 days)
+	cutoff := time.Now().AddDate(0, 0, -loadConfig().RetentionDays).Format("2006-01-02 15:04:05")
 
 	result, err := db.Exec(`
 		DELETE FROM messages
 		WHERE sent_at < ?`,
-		thirtyDaysAgo,
+		cutoff,
 	)
 
 	if err != nil {
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"os/exec"
+	"strings"
+)
+
+// Detached-session detection: keystrokes injected into a detached tmux
+// session land in the pane but often go unnoticed by the agent, so such
+// deliveries get flagged ("detached" status in the message log) and, with
+// detached_inbox: true in the config, also recorded to the agent's inbox.
+
+// paneSessionDetached reports whether the session owning paneID
+// (session:window.pane) currently has no attached client
+func paneSessionDetached(paneID string) bool {
+	session := paneID
+	if idx := strings.Index(paneID, ":"); idx >= 0 {
+		session = paneID[:idx]
+	}
+
+	output, err := exec.Command("tmux", "display-message", "-p", "-t", session, "#{session_attached}").Output()
+	if err != nil {
+		return false // Can't tell - assume attached rather than spam inboxes
+	}
+	return strings.TrimSpace(string(output)) == "0"
+}
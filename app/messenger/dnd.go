@@ -0,0 +1,41 @@
+package main
+
+import (
+	"strings"
+	"time"
+)
+
+// Do-not-disturb: agents flagged dnd in the registry (or inside their
+// dnd_schedule quiet hours) never get keystrokes injected. Messages to them
+// are logged with status "dnd" so they land in the TUI's queue view for
+// later retry, keeping long-running generations uninterrupted.
+
+// agentInDND reports whether the agent is currently do-not-disturb
+func agentInDND(agent *RegistryEntry) bool {
+	if agent.DND {
+		return true
+	}
+	return quietHoursActive(agent.DNDSchedule, time.Now())
+}
+
+// quietHoursActive reports whether now falls inside a "HH:MM-HH:MM" local
+// schedule; overnight windows like 22:00-07:00 wrap past midnight
+func quietHoursActive(schedule string, now time.Time) bool {
+	parts := strings.Split(schedule, "-")
+	if len(parts) != 2 {
+		return false
+	}
+	start, err1 := time.Parse("15:04", strings.TrimSpace(parts[0]))
+	end, err2 := time.Parse("15:04", strings.TrimSpace(parts[1]))
+	if err1 != nil || err2 != nil {
+		return false
+	}
+
+	minutes := now.Hour()*60 + now.Minute()
+	startMin := start.Hour()*60 + start.Minute()
+	endMin := end.Hour()*60 + end.Minute()
+	if startMin <= endMin {
+		return minutes >= startMin && minutes < endMin
+	}
+	return minutes >= startMin || minutes < endMin
+}
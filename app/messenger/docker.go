@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// dockerContainerFor returns the container name for agents registered with a
+// "docker:<container>" machine, and whether this agent lives in a container.
+func dockerContainerFor(agent *RegistryEntry) (string, bool) {
+	if strings.HasPrefix(agent.Machine, "docker:") {
+		return strings.TrimPrefix(agent.Machine, "docker:"), true
+	}
+	return "", false
+}
+
+// findContainerPane locates the agent's tmux pane inside a container by
+// matching directory and agent type, mirroring findAgentPane for the host.
+func findContainerPane(container string, agent *RegistryEntry) string {
+	cmd := exec.Command("docker", "exec", container, "tmux", "list-panes", "-a", "-F",
+		"#{session_name}:#{window_index}.#{pane_index}:#{pane_current_command}:#{pane_current_path}")
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		parts := strings.Split(strings.TrimSpace(line), ":")
+		if len(parts) != 4 {
+			continue
+		}
+
+		paneID := parts[0] + ":" + parts[1]
+		command := parts[2]
+		directory := parts[3]
+
+		if directory != agent.Directory && !strings.HasPrefix(directory, agent.Directory) {
+			continue
+		}
+		if detectAgentType(command) == agent.AgentType {
+			return paneID
+		}
+	}
+
+	return ""
+}
+
+// sendDockerMessage delivers a message to an agent inside a container using
+// docker exec + tmux send-keys, with the same formatting and staggered Enter
+// presses as local delivery.
+func sendDockerMessage(container, message string, targetAgent *RegistryEntry, registry []RegistryEntry) bool {
+	paneID := findContainerPane(container, targetAgent)
+	if paneID == "" {
+		return false
+	}
+
+	senderInfo := detectSenderFromRegistry(registry)
+	formattedMessage := message
+	if senderInfo != "" && senderInfo != "unknown" {
+		formattedMessage = fmt.Sprintf(
+			"{Receiving msg from: %s} \"%s\" {When ready to respond use: msg --from %s %s 'your return message'}",
+			senderInfo, message, targetAgent.Name, senderInfo)
+	}
+
+	cmd := exec.Command("docker", "exec", container, "tmux", "send-keys", "-t", paneID, formattedMessage)
+	if err := cmd.Run(); err != nil {
+		return false
+	}
+
+	// Staggered Enter presses for reliability
+	time.Sleep(100 * time.Millisecond)
+	exec.Command("docker", "exec", container, "tmux", "send-keys", "-t", paneID, "C-m").Run()
+
+	time.Sleep(100 * time.Millisecond)
+	exec.Command("docker", "exec", container, "tmux", "send-keys", "-t", paneID, "C-m").Run()
+
+	return true
+}
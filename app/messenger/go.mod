@@ -2,4 +2,18 @@ module messenger
 
 go 1.25.1
 
-require github.com/mattn/go-sqlite3 v1.14.32 // indirect
+require (
+	github.com/klauspost/compress v1.18.5
+	github.com/mattn/go-sqlite3 v1.14.32
+	github.com/nats-io/nats.go v1.53.1
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/mattn/go-runewidth v0.0.16
+	github.com/nats-io/nkeys v0.4.15 // indirect
+	github.com/nats-io/nuid v1.0.1 // indirect
+	github.com/rivo/uniseg v0.4.7 // indirect
+	golang.org/x/crypto v0.49.0 // indirect
+	golang.org/x/sys v0.42.0 // indirect
+)
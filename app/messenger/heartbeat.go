@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Presence tracking: `msg --heartbeat [name]` records a last-seen timestamp
+// for an agent in ~/.slaygent/heartbeats.json so the TUI can distinguish
+// "registered but dead" from "active". Agents (or a wrapper script) are
+// expected to touch their heartbeat periodically.
+
+// heartbeatsPath returns the heartbeat file location under ~/.slaygent
+func heartbeatsPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "heartbeats.json"
+	}
+	return filepath.Join(home, ".slaygent", "heartbeats.json")
+}
+
+// loadHeartbeats reads the heartbeat map (agent name -> RFC3339 timestamp).
+// Returns an empty map when no heartbeats have been recorded yet.
+func loadHeartbeats() map[string]string {
+	heartbeats := make(map[string]string)
+
+	data, err := os.ReadFile(heartbeatsPath())
+	if err != nil {
+		return heartbeats
+	}
+
+	json.Unmarshal(data, &heartbeats)
+	return heartbeats
+}
+
+// recordHeartbeat updates the last-seen timestamp for an agent
+func recordHeartbeat(name string) error {
+	heartbeats := loadHeartbeats()
+	heartbeats[name] = time.Now().Format(time.RFC3339)
+
+	data, err := json.MarshalIndent(heartbeats, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := heartbeatsPath()
+	os.MkdirAll(filepath.Dir(path), 0755)
+	return os.WriteFile(path, data, 0644)
+}
+
+// runHeartbeat handles `msg --heartbeat [name]`. Without a name, the sending
+// agent is detected from the registry by working directory.
+func runHeartbeat(args []string) {
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	} else {
+		registry := loadRegistry()
+		if registry == nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to load registry\n")
+			os.Exit(1)
+		}
+		name = detectSenderFromRegistry(registry)
+		if name == "" {
+			fmt.Fprintf(os.Stderr, "Error: could not detect agent from current directory, use: msg --heartbeat <name>\n")
+			os.Exit(1)
+		}
+	}
+
+	if err := recordHeartbeat(name); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to record heartbeat: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Heartbeat recorded for %s\n", name)
+}
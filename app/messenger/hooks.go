@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Hook scripts configured in ~/.slaygent/config.yaml under hooks:. Each
+// script receives the message as JSON on stdin and can transform the body
+// by printing a replacement to stdout; a non-zero exit from pre_send vetoes
+// delivery. This is the extension point for org policies like scrubbing
+// secrets before a message is injected into another agent.
+
+type msgHooks struct {
+	PreSend     string `yaml:"pre_send,omitempty"`
+	PostDeliver string `yaml:"post_deliver,omitempty"`
+	OnReceive   string `yaml:"on_receive,omitempty"`
+}
+
+type hookPayload struct {
+	Hook     string `json:"hook"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Message  string `json:"message"`
+}
+
+// runHook executes one hook script, returning the possibly transformed
+// message and whether delivery may proceed
+func runHook(hook, script, sender, receiver, message string) (string, bool) {
+	payload, err := json.Marshal(hookPayload{
+		Hook:     hook,
+		Sender:   sender,
+		Receiver: receiver,
+		Message:  message,
+	})
+	if err != nil {
+		return message, true
+	}
+
+	cmd := exec.Command(script)
+	cmd.Stdin = bytes.NewReader(payload)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return message, false
+	}
+
+	if transformed := strings.TrimSpace(out.String()); transformed != "" {
+		return transformed, true
+	}
+	return message, true
+}
+
+// firePostDeliver runs the post-deliver hook after a successful dispatch;
+// it is informational only, so output and exit code are ignored
+func firePostDeliver(sender, receiver, message string) {
+	if script := loadConfig().Hooks.PostDeliver; script != "" {
+		runHook("post-deliver", script, sender, receiver, message)
+	}
+}
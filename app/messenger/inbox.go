@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Inbox delivery: instead of keystroke injection, messages are appended to
+// .slaygent/inbox.md inside the agent's directory, for agents that poll
+// files or when injection is too disruptive. Selected per send with
+// `msg --inbox ...` or per agent with "delivery": "inbox" in the registry.
+
+// inboxPath returns the inbox file location inside the agent's directory
+func inboxPath(agent *RegistryEntry) string {
+	return filepath.Join(agent.Directory, ".slaygent", "inbox.md")
+}
+
+// appendInboxMessage appends a formatted message entry to the agent's inbox
+// file, creating it on first delivery
+func appendInboxMessage(agent *RegistryEntry, sender, message string) error {
+	path := inboxPath(agent)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	from := sender
+	if from == "" {
+		from = "unknown"
+	}
+
+	entry := fmt.Sprintf("## %s from %s\n\n%s\n", time.Now().Format("2006-01-02 15:04:05"), from, message)
+	if sender != "" {
+		entry += fmt.Sprintf("\n*Respond with: `msg --from %s %s 'your return message'`*\n", agent.Name, sender)
+	}
+	entry += "\n"
+
+	_, err = f.WriteString(entry)
+	return err
+}
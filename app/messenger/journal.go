@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Journal of message log entries that could not be written because the
+// database was locked by a concurrent msg process. Entries are appended as
+// JSON lines and replayed the next time the database opens cleanly.
+
+type journalEntry struct {
+	Sender      string `json:"sender"`
+	SenderDir   string `json:"sender_dir"`
+	Receiver    string `json:"receiver"`
+	ReceiverDir string `json:"receiver_dir"`
+	Message     string `json:"message"`
+	AckID       string `json:"ack_id,omitempty"`
+	ReplyTo     string `json:"reply_to,omitempty"`   // Parent handle from --reply-to (row id or ack id)
+	Status      string `json:"status,omitempty"`     // "" means sent, "failed" otherwise
+	Compressed  int    `json:"compressed,omitempty"` // 1 when Message is gzip+base64
+	SentAt      string `json:"sent_at"`              // UTC, "2006-01-02 15:04:05"
+}
+
+func journalPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".slaygent", "log-journal.jsonl")
+}
+
+// queueJournalEntry appends one entry to the journal file
+func queueJournalEntry(e journalEntry) error {
+	path := journalPath()
+	if path == "" {
+		return fmt.Errorf("cannot determine journal path")
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// replayJournal inserts any queued entries into the database. Entries that
+// still fail stay queued for the next run; corrupt lines are dropped.
+func replayJournal() {
+	path := journalPath()
+	if path == "" {
+		return
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return // No journal, nothing to replay
+	}
+
+	var remaining []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var e journalEntry
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			continue
+		}
+		if err := logMessageEntry(e); err != nil {
+			remaining = append(remaining, line)
+		}
+	}
+
+	if len(remaining) == 0 {
+		os.Remove(path)
+	} else {
+		os.WriteFile(path, []byte(strings.Join(remaining, "\n")+"\n"), 0644)
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Circuit breaker against recursive message loops. Two auto-responding
+// agents can trap each other in an infinite ping-pong exchange, so before
+// an agent-to-agent delivery we look at the pair's recent traffic: many
+// rapid, near-identical messages trips the breaker and the send is refused.
+// Blocked messages are never logged, so the window slides past on its own
+// and delivery resumes once the pair has been quiet.
+
+const (
+	loopWindow     = 2 * time.Minute // How far back to examine the pair's traffic
+	loopThreshold  = 6               // Messages inside the window before the breaker can trip
+	loopSimilarity = 0.8             // Minimum average body similarity to call it a loop
+)
+
+// checkMessageLoop reports whether sender→receiver traffic looks like a
+// ping-pong loop, with a human-readable reason when it does
+func checkMessageLoop(sender, receiver, message string) (bool, string) {
+	if db == nil {
+		return false, ""
+	}
+
+	since := time.Now().UTC().Add(-loopWindow).Format("2006-01-02 15:04:05")
+	rows, err := db.Query(`
+		SELECT message, COALESCE(compressed, 0)
+		FROM messages
+		WHERE sent_at >= ?
+		  AND ((sender_name = ? AND receiver_name = ?)
+		    OR (sender_name = ? AND receiver_name = ?))
+		ORDER BY sent_at DESC LIMIT 20`,
+		since, sender, receiver, receiver, sender)
+	if err != nil {
+		return false, "" // Never block delivery over a metrics query
+	}
+	defer rows.Close()
+
+	var bodies []string
+	for rows.Next() {
+		var body string
+		var compressed int
+		if err := rows.Scan(&body, &compressed); err != nil {
+			continue
+		}
+		bodies = append(bodies, decompressBody(body, compressed))
+	}
+
+	if len(bodies) < loopThreshold {
+		return false, ""
+	}
+
+	// Compare the outgoing message against the recent bodies; a loop shows
+	// up as the same content bouncing back and forth with minor variations
+	similar := 0
+	for _, body := range bodies {
+		if bodySimilarity(message, body) >= loopSimilarity {
+			similar++
+		}
+	}
+	if similar < loopThreshold {
+		return false, ""
+	}
+
+	return true, fmt.Sprintf("%d near-identical messages between %s and %s in the last %s",
+		similar, sender, receiver, loopWindow)
+}
+
+// bodySimilarity computes the Dice coefficient of the two bodies' word sets,
+// 0 (disjoint) to 1 (identical)
+func bodySimilarity(a, b string) float64 {
+	wordsA := wordSet(a)
+	wordsB := wordSet(b)
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for word := range wordsA {
+		if wordsB[word] {
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(wordsA)+len(wordsB))
+}
+
+func wordSet(s string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(strings.ToLower(s)) {
+		set[word] = true
+	}
+	return set
+}
+
+// tripLoopBreaker records the event for the TUI activity feed and tells the
+// human on stderr how to intervene
+func tripLoopBreaker(sender, receiver, reason string) {
+	LogEvent("loop_breaker", fmt.Sprintf("%s ↔ %s paused: %s", sender, receiver, reason))
+	fmt.Fprintf(os.Stderr, "Error: delivery paused by loop breaker - %s\n", reason)
+	fmt.Fprintf(os.Stderr, "The pair can resume once quiet for %s; send manually with msg --as-human to intervene.\n", loopWindow)
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// MCP (Model Context Protocol) server mode: `msg --mcp` speaks JSON-RPC 2.0
+// over stdio and exposes send_message, list_agents, and get_conversation as
+// tools, so MCP-capable agents can message peers natively instead of shelling
+// out to msg with brittle wrappers.
+
+type jsonRPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *jsonRPCError   `json:"error,omitempty"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// mcpTool describes a tool in the tools/list response
+type mcpTool struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	InputSchema map[string]interface{} `json:"inputSchema"`
+}
+
+// runMCPServer reads JSON-RPC requests line by line from stdin and writes
+// responses to stdout until EOF.
+func runMCPServer() {
+	scanner := bufio.NewScanner(os.Stdin)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	encoder := json.NewEncoder(os.Stdout)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var req jsonRPCRequest
+		if err := json.Unmarshal([]byte(line), &req); err != nil {
+			continue // Skip malformed input
+		}
+
+		resp := handleMCPRequest(req)
+		if resp != nil {
+			encoder.Encode(resp)
+		}
+	}
+}
+
+func handleMCPRequest(req jsonRPCRequest) *jsonRPCResponse {
+	resp := &jsonRPCResponse{JSONRPC: "2.0", ID: req.ID}
+
+	switch req.Method {
+	case "initialize":
+		resp.Result = map[string]interface{}{
+			"protocolVersion": "2024-11-05",
+			"capabilities":    map[string]interface{}{"tools": map[string]interface{}{}},
+			"serverInfo":      map[string]interface{}{"name": "slaygent-msg", "version": "1.0.0"},
+		}
+
+	case "notifications/initialized":
+		return nil // Notification - no response
+
+	case "tools/list":
+		resp.Result = map[string]interface{}{"tools": mcpToolList()}
+
+	case "tools/call":
+		var params struct {
+			Name      string                 `json:"name"`
+			Arguments map[string]interface{} `json:"arguments"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			resp.Error = &jsonRPCError{Code: -32602, Message: "invalid params"}
+			break
+		}
+
+		text, err := callMCPTool(params.Name, params.Arguments)
+		if err != nil {
+			resp.Result = map[string]interface{}{
+				"content": []map[string]string{{"type": "text", "text": err.Error()}},
+				"isError": true,
+			}
+		} else {
+			resp.Result = map[string]interface{}{
+				"content": []map[string]string{{"type": "text", "text": text}},
+			}
+		}
+
+	default:
+		resp.Error = &jsonRPCError{Code: -32601, Message: "method not found: " + req.Method}
+	}
+
+	return resp
+}
+
+func mcpToolList() []mcpTool {
+	return []mcpTool{
+		{
+			Name:        "send_message",
+			Description: "Send a message to another registered AI agent",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"agent":   map[string]string{"type": "string", "description": "Registered name of the receiving agent"},
+					"message": map[string]string{"type": "string", "description": "Message text to deliver"},
+					"from":    map[string]string{"type": "string", "description": "Sender name for conversation logging (optional)"},
+				},
+				"required": []string{"agent", "message"},
+			},
+		},
+		{
+			Name:        "list_agents",
+			Description: "List all registered agents with their type and directory",
+			InputSchema: map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{},
+			},
+		},
+		{
+			Name:        "get_conversation",
+			Description: "Get recent message history between two agents",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"agent1": map[string]string{"type": "string", "description": "First agent name"},
+					"agent2": map[string]string{"type": "string", "description": "Second agent name"},
+				},
+				"required": []string{"agent1", "agent2"},
+			},
+		},
+	}
+}
+
+func callMCPTool(name string, args map[string]interface{}) (string, error) {
+	stringArg := func(key string) string {
+		if v, ok := args[key].(string); ok {
+			return v
+		}
+		return ""
+	}
+
+	switch name {
+	case "send_message":
+		agentName := stringArg("agent")
+		message := stringArg("message")
+		sender := stringArg("from")
+		if agentName == "" || message == "" {
+			return "", fmt.Errorf("agent and message are required")
+		}
+		return mcpSendMessage(sender, agentName, message)
+
+	case "list_agents":
+		registry := loadRegistry()
+		if registry == nil {
+			return "", fmt.Errorf("failed to load registry")
+		}
+		var lines []string
+		for _, agent := range registry {
+			lines = append(lines, fmt.Sprintf("%s (%s) - %s", agent.Name, agent.AgentType, agent.Directory))
+		}
+		if len(lines) == 0 {
+			return "No agents registered", nil
+		}
+		return strings.Join(lines, "\n"), nil
+
+	case "get_conversation":
+		agent1 := stringArg("agent1")
+		agent2 := stringArg("agent2")
+		if agent1 == "" || agent2 == "" {
+			return "", fmt.Errorf("agent1 and agent2 are required")
+		}
+		return GetConversationText(agent1, agent2)
+	}
+
+	return "", fmt.Errorf("unknown tool: %s", name)
+}
+
+// mcpSendMessage routes a tool call through the same delivery path as the CLI
+func mcpSendMessage(senderName, agentName, message string) (string, error) {
+	registry := loadRegistry()
+	if registry == nil {
+		return "", fmt.Errorf("failed to load registry")
+	}
+
+	var targetAgent *RegistryEntry
+	for _, agent := range registry {
+		if agent.Name == agentName {
+			targetAgent = &agent
+			break
+		}
+	}
+	if targetAgent == nil {
+		return "", fmt.Errorf("agent '%s' not found in registry", agentName)
+	}
+
+	if container, ok := dockerContainerFor(targetAgent); ok {
+		if !sendDockerMessage(container, message, targetAgent, registry) {
+			return "", fmt.Errorf("failed to deliver to %s in container %s", agentName, container)
+		}
+	} else {
+		pane := findAgentPane(targetAgent)
+		if pane == nil {
+			pane = findTerminalPane(targetAgent)
+		}
+		if pane == nil {
+			return "", fmt.Errorf("%s (%s) not found in %s", targetAgent.Name, targetAgent.AgentType, targetAgent.Directory)
+		}
+		if !sendMessage(pane.ID, message, targetAgent, registry) {
+			return "", fmt.Errorf("failed to deliver message to %s", agentName)
+		}
+	}
+
+	if senderName != "" {
+		if err := LogMessageExplicit(senderName, targetAgent, message, registry); err != nil {
+			return fmt.Sprintf("Message sent to %s (logging failed: %v)", agentName, err), nil
+		}
+	}
+
+	return "Message sent to " + agentName, nil
+}
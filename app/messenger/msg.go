@@ -7,6 +7,8 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -15,12 +17,18 @@ type RegistryEntry struct {
 	Name      string `json:"name"`
 	AgentType string `json:"agent_type"`
 	Directory string `json:"directory"`
+	Machine   string `json:"machine"`            // "host", or "docker:<container>" for containerized agents
+	Delivery  string `json:"delivery,omitempty"` // "" (keystroke injection), "inbox" (append to inbox file), or "typewriter" (chunked injection)
+	Groups    []string `json:"groups,omitempty"` // Group names targetable with `msg @group`
+	DND       bool     `json:"dnd,omitempty"`    // Do-not-disturb: queue instead of injecting keystrokes
+	DNDSchedule string `json:"dnd_schedule,omitempty"` // Recurring quiet hours, "22:00-07:00" local time
 }
 
 type Pane struct {
 	ID        string // session:window.pane
 	Command   string
 	Directory string
+	PID       string // pane root process PID
 }
 
 func main() {
@@ -32,35 +40,172 @@ func main() {
 	defer CloseDB()
 
 	if len(os.Args) < 2 {
-		fmt.Fprintf(os.Stderr, "Usage:\n  msg <agent_name> <message>\n  msg --from <sender> <agent_name> <message>\n  msg --status\n")
+		fmt.Fprintf(os.Stderr, "Usage:\n  msg <agent_name> <message>\n  msg --from <sender> <agent_name> <message>\n  msg --as-human <agent_name> <message>\n  msg --status\n  msg --mcp\n")
 		os.Exit(1)
 	}
 
+	if os.Args[1] == "--version" {
+		printVersion()
+		return
+	}
+
 	if os.Args[1] == "--status" {
 		showStatus()
 		os.Exit(0)
 	}
 
-	// Parse --from flag if present
+	if os.Args[1] == "--mcp" {
+		runMCPServer()
+		os.Exit(0)
+	}
+
+	if os.Args[1] == "--listen" {
+		runBrokerListener()
+		os.Exit(0)
+	}
+
+	if os.Args[1] == "--heartbeat" {
+		runHeartbeat(os.Args[2:])
+		os.Exit(0)
+	}
+
+	if os.Args[1] == "--ack" {
+		runAck(os.Args[2:])
+		os.Exit(0)
+	}
+
+	if os.Args[1] == "--pending" {
+		runPending()
+		os.Exit(0)
+	}
+
+	if os.Args[1] == "--sent" {
+		runSent(os.Args[2:])
+		os.Exit(0)
+	}
+
+	if os.Args[1] == "--set-status" {
+		runSetStatus(os.Args[2:])
+		os.Exit(0)
+	}
+
+	if os.Args[1] == "--context" {
+		runContext(os.Args[2:])
+		os.Exit(0)
+	}
+
+	if os.Args[1] == "--watchdog" {
+		runWatchdog()
+		os.Exit(0)
+	}
+
+	// Unrecognized flags may belong to an installed plugin subcommand
+	if strings.HasPrefix(os.Args[1], "--") && os.Args[1] != "--from" && os.Args[1] != "--inbox" && os.Args[1] != "--expect-reply" && os.Args[1] != "--as-human" && os.Args[1] != "--reply-to" {
+		if tryPluginCommand(strings.TrimPrefix(os.Args[1], "--"), os.Args[2:]) {
+			os.Exit(0)
+		}
+		fmt.Fprintf(os.Stderr, "Error: unknown flag %s\n", os.Args[1])
+		os.Exit(1)
+	}
+
+	// Parse flags - --inbox may precede --from
 	var senderName string
 	var agentName string
 	var message string
 
-	if len(os.Args) >= 5 && os.Args[1] == "--from" {
-		// Format: msg --from <sender> <receiver> <message>
-		senderName = os.Args[2]
-		agentName = os.Args[3]
-		message = strings.Join(os.Args[4:], " ")
-	} else if len(os.Args) >= 3 {
-		// Format: msg <receiver> <message>
-		agentName = os.Args[1]
-		message = strings.Join(os.Args[2:], " ")
+	args := os.Args[1:]
+	inboxMode := false
+	if args[0] == "--inbox" {
+		inboxMode = true
+		args = args[1:]
+	}
+
+	// --expect-reply <duration> may appear anywhere before the message
+	var expectReply time.Duration
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == "--expect-reply" {
+			d, err := time.ParseDuration(args[i+1])
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: bad --expect-reply duration %q (use e.g. 15m, 2h)\n", args[i+1])
+				os.Exit(1)
+			}
+			expectReply = d
+			args = append(args[:i], args[i+2:]...)
+			break
+		}
+	}
+
+	// --reply-to <id> links this message to its parent for threading; the
+	// handle is either a message row id or the ack ID from the delivered
+	// wrapper
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == "--reply-to" {
+			pendingReplyTo = args[i+1]
+			args = append(args[:i], args[i+2:]...)
+			break
+		}
+	}
+
+	// --as-human sends on behalf of the human operator, a pseudo-agent
+	// ("me" unless human_name is configured) that cwd detection can never
+	// find - without it these messages were dropped from the log
+	if args[0] == "--as-human" {
+		args = append([]string{"--from", loadConfig().HumanName}, args[1:]...)
+	}
+
+	// --template <name> expands a coordination template in place of the flag
+	// pair, so any free text that follows fills in the placeholders
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == "--template" {
+			text := templateText(args[i+1])
+			if text == "" {
+				fmt.Fprintf(os.Stderr, "Error: unknown template %q (have: %s)\n", args[i+1], templateNames())
+				os.Exit(1)
+			}
+			args = append(args[:i], append([]string{text}, args[i+2:]...)...)
+			break
+		}
+	}
+
+	// --quick <name> substitutes a canned reply for the message body
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == "--quick" {
+			quickText := quickReplyText(args[i+1])
+			if quickText == "" {
+				fmt.Fprintf(os.Stderr, "Error: unknown quick reply %q (have: %s)\n", args[i+1], quickReplyNames())
+				os.Exit(1)
+			}
+			args = append(args[:i], args[i+2:]...)
+			args = append(args, quickText)
+			break
+		}
+	}
+
+	if len(args) >= 4 && args[0] == "--from" {
+		// Format: msg [--inbox] --from <sender> <receiver> <message>
+		senderName = args[1]
+		agentName = args[2]
+		message = strings.Join(args[3:], " ")
+	} else if len(args) >= 2 {
+		// Format: msg [--inbox] <receiver> <message>
+		agentName = args[0]
+		message = strings.Join(args[1:], " ")
 	} else {
 		fmt.Fprintf(os.Stderr, "Error: missing message\n")
 		fmt.Fprintf(os.Stderr, "Usage: msg <agent_name> <message>\n")
 		os.Exit(1)
 	}
 
+	// Pre-send hook may veto or transform the outgoing message
+	if script := loadConfig().Hooks.PreSend; script != "" {
+		transformed, ok := runHook("pre-send", script, senderName, agentName, message)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: message to %s blocked by pre-send hook\n", agentName)
+			os.Exit(1)
+		}
+		message = transformed
+	}
+
 	// Load registry
 	registry := loadRegistry()
 	if registry == nil {
@@ -68,6 +213,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	// "@group" fans the message out to every member of that group
+	if strings.HasPrefix(agentName, "@") {
+		sendToGroup(strings.TrimPrefix(agentName, "@"), senderName, message, inboxMode, registry)
+		return
+	}
+
 	// Find agent
 	var targetAgent *RegistryEntry
 	for _, agent := range registry {
@@ -78,6 +229,21 @@ func main() {
 	}
 
 	if targetAgent == nil {
+		// Unknown locally - publish to the broker if one is configured, so
+		// agents on other machines can receive without SSH fan-out
+		if cfg := loadBrokerConfig(); cfg != nil {
+			sender := senderName
+			if sender == "" {
+				sender = detectSenderFromRegistry(registry)
+			}
+			if err := publishToBroker(cfg, sender, agentName, message); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("Message published to broker for %s\n", agentName)
+			os.Exit(0)
+		}
+
 		fmt.Fprintf(os.Stderr, "Error: agent '%s' not found in registry\n", agentName)
 		fmt.Fprintln(os.Stderr, "Registered agents:")
 		for _, agent := range registry {
@@ -86,20 +252,122 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Configured policies run first: length and content limits apply to
+	// everyone, recipient allowlists only to agents
+	if err := checkPolicy(loadConfig().Policy, senderName, agentName, message, senderName == loadConfig().HumanName); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: blocked by policy: %v\n", err)
+		LogEvent("policy", fmt.Sprintf("blocked %s -> %s: %v", senderName, agentName, err))
+		os.Exit(1)
+	}
+
+	// Agent-to-agent sends go through the loop breaker; the human operator
+	// always gets through so they can break a deadlock by hand
+	if senderName != "" && senderName != loadConfig().HumanName {
+		if tripped, reason := checkMessageLoop(senderName, agentName, message); tripped {
+			tripLoopBreaker(senderName, agentName, reason)
+			os.Exit(1)
+		}
+	}
+
+	// Inbox delivery appends to a file instead of injecting keystrokes
+	if inboxMode || targetAgent.Delivery == "inbox" {
+		sender := senderName
+		if sender == "" {
+			sender = detectSenderFromRegistry(registry)
+		}
+		if err := appendInboxMessage(targetAgent, sender, message); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to write inbox for %s: %v\n", agentName, err)
+			os.Exit(1)
+		}
+		fmt.Printf("Message delivered to %s's inbox\n", agentName)
+		LogEvent("message_send", fmt.Sprintf("to %s via inbox", agentName))
+		firePostDeliver(sender, agentName, message)
+		if sender != "" && sender != "unknown" {
+			if err := LogMessageExplicit(sender, targetAgent, message, registry); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to log message: %v\n", err)
+			}
+		}
+		recordExpectation(senderName, agentName, registry, expectReply)
+		return
+	}
+
+	// Do-not-disturb agents get the message queued (logged with status
+	// "dnd" for the TUI queue view) instead of keystroke-injected
+	if agentInDND(targetAgent) {
+		sender := senderName
+		if sender == "" {
+			sender = detectSenderFromRegistry(registry)
+		}
+		// The logged row is the queue - with no sender nothing would be
+		// stored, so refuse instead of claiming the message was queued
+		if sender == "" || sender == "unknown" {
+			fmt.Fprintf(os.Stderr, "Error: %s is in do-not-disturb and the sender is unknown - resend with --from <your_agent_name> so the message can be queued\n", agentName)
+			os.Exit(1)
+		}
+		pendingStatus = "dnd"
+		if err := LogMessageExplicit(sender, targetAgent, message, registry); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to log message: %v\n", err)
+		}
+		fmt.Printf("%s is in do-not-disturb 🌙 - message queued\n", agentName)
+		LogEvent("message_send", fmt.Sprintf("to %s queued (dnd)", agentName))
+		return
+	}
+
+	// Containerized agents get their own delivery path via docker exec
+	if container, ok := dockerContainerFor(targetAgent); ok {
+		if sendDockerMessage(container, message, targetAgent, registry) {
+			fmt.Printf("Message sent to %s (container %s)\n", agentName, container)
+			LogEvent("message_send", fmt.Sprintf("to %s in container %s", agentName, container))
+			firePostDeliver(senderName, agentName, message)
+			if senderName != "" {
+				if err := LogMessageExplicit(senderName, targetAgent, message, registry); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to log message: %v\n", err)
+				}
+			}
+			recordExpectation(senderName, agentName, registry, expectReply)
+			return
+		}
+		fmt.Fprintf(os.Stderr, "Failed to send message to %s in container %s\n", agentName, container)
+		logFailedDispatch(senderName, targetAgent, message, registry)
+		os.Exit(1)
+	}
+
 	// Find pane - ALWAYS use directory-based search for correctness
 	// Previous optimization using findAgentPaneByType() for established conversations
 	// caused misrouting when multiple agents of the same type were active
 	var pane *Pane
 	pane = findAgentPane(targetAgent)
+	if pane == nil {
+		// Not in tmux - check non-tmux terminal backends (WezTerm, kitty)
+		pane = findTerminalPane(targetAgent)
+	}
 	if pane == nil {
 		fmt.Fprintf(os.Stderr, "Error: %s (%s) not found in %s\n",
 			targetAgent.Name, targetAgent.AgentType, targetAgent.Directory)
+		logFailedDispatch(senderName, targetAgent, message, registry)
 		os.Exit(1)
 	}
 
 	// Send message
 	if sendMessage(pane.ID, message, targetAgent, registry) {
 		fmt.Printf("Message sent to %s\n", agentName)
+		// Keystrokes into a detached session often go unnoticed - flag the
+		// logged message and optionally also record it to the inbox
+		if paneSessionDetached(pane.ID) {
+			pendingStatus = "detached"
+			fmt.Printf("Note: %s's tmux session is detached\n", agentName)
+			if loadConfig().DetachedInbox {
+				sender := senderName
+				if sender == "" {
+					sender = detectSenderFromRegistry(registry)
+				}
+				if err := appendInboxMessage(targetAgent, sender, message); err == nil {
+					fmt.Printf("Message also recorded to %s's inbox\n", agentName)
+				}
+			}
+		}
+		LogEvent("message_send", fmt.Sprintf("to %s in %s", agentName, targetAgent.Directory))
+		firePostDeliver(senderName, agentName, message)
 
 		// Log message to database
 		if senderName != "" {
@@ -116,8 +384,82 @@ func main() {
 				}
 			}
 		}
+		recordExpectation(senderName, agentName, registry, expectReply)
 	} else {
 		fmt.Fprintf(os.Stderr, "Failed to send message to %s\n", agentName)
+		logFailedDispatch(senderName, targetAgent, message, registry)
+		os.Exit(1)
+	}
+}
+
+// recordExpectation stores a reply expectation after a successful send when
+// --expect-reply was given. No-op without a detectable sender.
+func recordExpectation(senderName, agentName string, registry []RegistryEntry, within time.Duration) {
+	if within <= 0 {
+		return
+	}
+
+	expecter := senderName
+	if expecter == "" {
+		expecter = detectSenderFromRegistry(registry)
+	}
+	if expecter == "" || expecter == "unknown" {
+		fmt.Fprintf(os.Stderr, "Warning: --expect-reply ignored (could not determine sender, use --from)\n")
+		return
+	}
+
+	if err := ExpectReply(expecter, agentName, within); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to record reply expectation: %v\n", err)
+	} else {
+		fmt.Printf("Expecting a reply from %s within %s\n", agentName, within)
+	}
+}
+
+// sendToGroup delivers one message to every registry member of a group by
+// re-invoking msg per member, so each delivery keeps its normal path (pane
+// injection, inbox, docker). The sender is skipped when it is in the group.
+func sendToGroup(group, senderName, message string, inboxMode bool, registry []RegistryEntry) {
+	var members []string
+	for _, agent := range registry {
+		for _, g := range agent.Groups {
+			if g == group && agent.Name != senderName {
+				members = append(members, agent.Name)
+				break
+			}
+		}
+	}
+
+	if len(members) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no agents in group '@%s'\n", group)
+		os.Exit(1)
+	}
+
+	binary, err := os.Executable()
+	if err != nil {
+		binary = os.Args[0]
+	}
+
+	failed := 0
+	for _, member := range members {
+		var args []string
+		if inboxMode {
+			args = append(args, "--inbox")
+		}
+		if senderName != "" {
+			args = append(args, "--from", senderName)
+		}
+		args = append(args, member, message)
+
+		cmd := exec.Command(binary, args...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			failed++
+		}
+	}
+
+	fmt.Printf("Group @%s: delivered to %d of %d members\n", group, len(members)-failed, len(members))
+	if failed > 0 {
 		os.Exit(1)
 	}
 }
@@ -144,7 +486,7 @@ func loadRegistry() []RegistryEntry {
 
 func getTmuxPanes() []Pane {
 	cmd := exec.Command("tmux", "list-panes", "-a", "-F",
-		"#{session_name}:#{window_index}.#{pane_index}:#{pane_current_command}:#{pane_current_path}")
+		"#{session_name}:#{window_index}.#{pane_index}:#{pane_current_command}:#{pane_current_path}:#{pane_pid}")
 
 	output, err := cmd.Output()
 	if err != nil {
@@ -160,7 +502,7 @@ func getTmuxPanes() []Pane {
 		}
 
 		parts := strings.Split(line, ":")
-		if len(parts) != 4 {
+		if len(parts) != 5 {
 			continue
 		}
 
@@ -168,6 +510,7 @@ func getTmuxPanes() []Pane {
 			ID:        parts[0] + ":" + parts[1],
 			Command:   parts[2],
 			Directory: parts[3],
+			PID:       parts[4],
 		})
 	}
 
@@ -180,19 +523,9 @@ func findAgentPane(agent *RegistryEntry) *Pane {
 	// First try exact directory match (preferred)
 	for _, pane := range panes {
 		if pane.Directory == agent.Directory {
-			// Check command match
-			detectedType := detectAgentType(pane.Command)
-			if detectedType == agent.AgentType {
+			if paneMatchesAgentType(pane, agent.AgentType) {
 				return &pane
 			}
-
-			// For node processes, check deeper
-			if pane.Command == "node" {
-				actualType := detectNodeAgent(pane.ID)
-				if actualType == agent.AgentType {
-					return &pane
-				}
-			}
 		}
 	}
 
@@ -200,23 +533,57 @@ func findAgentPane(agent *RegistryEntry) *Pane {
 	for _, pane := range panes {
 		// Check if pane is in a subdirectory of the registered directory
 		if strings.HasPrefix(pane.Directory, agent.Directory) {
-			detectedType := detectAgentType(pane.Command)
-			if detectedType == agent.AgentType {
+			if paneMatchesAgentType(pane, agent.AgentType) {
 				return &pane
 			}
-
-			if pane.Command == "node" {
-				actualType := detectNodeAgent(pane.ID)
-				if actualType == agent.AgentType {
-					return &pane
-				}
-			}
 		}
 	}
 
 	return nil
 }
 
+// paneMatchesAgentType checks whether a pane is running the given agent type.
+// An explicit SLAYGENT_AGENT environment declaration wins over process-name
+// heuristics, which eliminates misdetection of wrappers and shell scripts.
+func paneMatchesAgentType(pane Pane, agentType string) bool {
+	if envType := detectPaneAgentEnv(pane.ID); envType != "" {
+		return envType == agentType
+	}
+
+	if detectAgentType(pane.Command) == agentType {
+		return true
+	}
+
+	// For node processes, check deeper
+	if pane.Command == "node" {
+		return detectNodeAgent(pane.PID) == agentType
+	}
+
+	return false
+}
+
+// detectPaneAgentEnv reads SLAYGENT_AGENT=<type>:<name> from the pane's tmux
+// environment and returns the declared type, or "" when not set.
+func detectPaneAgentEnv(paneID string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "tmux", "show-environment", "-t", paneID, "SLAYGENT_AGENT")
+	output, err := cmd.Output()
+	if err != nil {
+		return "" // Variable not set for this session
+	}
+
+	value := strings.TrimSpace(string(output))
+	if !strings.HasPrefix(value, "SLAYGENT_AGENT=") {
+		return ""
+	}
+	value = strings.TrimPrefix(value, "SLAYGENT_AGENT=")
+
+	parts := strings.SplitN(value, ":", 2)
+	return strings.ToLower(strings.TrimSpace(parts[0]))
+}
+
 // findAgentPaneByType finds an agent pane by type only (for established conversations)
 func findAgentPaneByType(agentType string) *Pane {
 	panes := getTmuxPanes()
@@ -229,7 +596,7 @@ func findAgentPaneByType(agentType string) *Pane {
 
 		// For node processes, check deeper
 		if pane.Command == "node" {
-			actualType := detectNodeAgent(pane.ID)
+			actualType := detectNodeAgent(pane.PID)
 			if actualType == agentType {
 				return &pane
 			}
@@ -258,52 +625,113 @@ func detectAgentType(command string) string {
 	return "unknown"
 }
 
-func detectNodeAgent(paneID string) string {
-	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
-	defer cancel()
+// processSnapshot is a one-shot capture of the process table so node panes can
+// be resolved without spawning pgrep/ps per pane.
+type processSnapshot struct {
+	commands map[string]string   // pid -> full command line
+	children map[string][]string // ppid -> child pids
+}
 
-	// Get pane PID
-	cmd := exec.CommandContext(ctx, "tmux", "display-message", "-p", "-t", paneID, "#{pane_pid}")
-	pidOutput, err := cmd.Output()
-	if err != nil {
-		return "unknown"
+// cachedSnapshot is taken lazily on first node-pane lookup and reused for the
+// rest of this invocation.
+var cachedSnapshot *processSnapshot
+
+func takeProcessSnapshot() *processSnapshot {
+	snapshot := &processSnapshot{
+		commands: make(map[string]string),
+		children: make(map[string][]string),
 	}
 
-	pid := strings.TrimSpace(string(pidOutput))
-	if pid == "" {
-		return "unknown"
+	// Native Windows has no ps - fall back to wmic
+	if runtime.GOOS == "windows" {
+		windowsProcessSnapshot(snapshot)
+		return snapshot
 	}
 
-	// Get child processes
-	cmd = exec.CommandContext(ctx, "pgrep", "-P", pid)
-	childOutput, err := cmd.Output()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "ps", "-eo", "pid=,ppid=,command=")
+	output, err := cmd.Output()
 	if err != nil {
-		return "unknown"
+		return snapshot
 	}
 
-	childPids := strings.Split(string(childOutput), "\n")
-	for _, childPid := range childPids {
-		childPid = strings.TrimSpace(childPid)
-		if childPid == "" {
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
 			continue
 		}
+		pid := fields[0]
+		ppid := fields[1]
+		snapshot.commands[pid] = strings.Join(fields[2:], " ")
+		snapshot.children[ppid] = append(snapshot.children[ppid], pid)
+	}
 
-		// Check child process command
-		cmd = exec.CommandContext(ctx, "ps", "-p", childPid, "-o", "command=")
-		cmdOutput, err := cmd.Output()
-		if err != nil {
+	return snapshot
+}
+
+// detectNodeAgent resolves what a node pane is actually running by walking the
+// pane PID's descendants in a shared ps snapshot.
+func detectNodeAgent(panePID string) string {
+	if panePID == "" {
+		return "unknown"
+	}
+
+	if cachedSnapshot == nil {
+		cachedSnapshot = takeProcessSnapshot()
+	}
+
+	visited := make(map[string]bool)
+	queue := append([]string{}, cachedSnapshot.children[panePID]...)
+
+	for len(queue) > 0 {
+		pid := queue[0]
+		queue = queue[1:]
+		if visited[pid] {
 			continue
 		}
+		visited[pid] = true
 
-		agentType := detectAgentType(string(cmdOutput))
-		if agentType != "unknown" {
+		if agentType := detectAgentType(cachedSnapshot.commands[pid]); agentType != "unknown" {
 			return agentType
 		}
+
+		queue = append(queue, cachedSnapshot.children[pid]...)
 	}
 
 	return "unknown"
 }
 
+// paneIdleThreshold is how long a pane must be quiet before we consider the
+// agent to be waiting for input rather than actively generating output.
+const paneIdleThreshold = 2 * time.Second
+
+// waitForPaneIdle polls the pane's last output timestamp and blocks until the
+// agent appears idle, so messages aren't injected mid-generation. Gives up
+// after 15 seconds and sends anyway.
+func waitForPaneIdle(paneID string) {
+	deadline := time.Now().Add(15 * time.Second)
+	for time.Now().Before(deadline) {
+		cmd := exec.Command("tmux", "display-message", "-p", "-t", paneID, "#{window_activity}")
+		output, err := cmd.Output()
+		if err != nil {
+			return // Can't determine activity - send immediately
+		}
+
+		epoch, err := strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64)
+		if err != nil || epoch <= 0 {
+			return
+		}
+
+		if time.Since(time.Unix(epoch, 0)) >= paneIdleThreshold {
+			return
+		}
+
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
 func sendMessage(paneID string, message string, targetAgent *RegistryEntry, registry []RegistryEntry) bool {
 	// Format message with sender info and response instructions
 	senderInfo := detectSenderFromRegistry(registry)
@@ -311,13 +739,92 @@ func sendMessage(paneID string, message string, targetAgent *RegistryEntry, regi
 
 	if senderInfo != "" && senderInfo != "unknown" {
 		// Add structured wrapper for receiving agent to parse
-		// Include receiver name so they know who to respond to with --from flag
+		// Include receiver name so they know who to respond to with --from flag,
+		// plus an ack ID so delivery can be confirmed with msg --ack
+		ackID := newAckID()
+		pendingAckID = ackID
 		formattedMessage = fmt.Sprintf(
-			"{Receiving msg from: %s} \"%s\" {When ready to respond use: msg --from %s %s 'your return message'}",
-			senderInfo, message, targetAgent.Name, senderInfo)
+			"{Receiving msg from: %s} \"%s\" {When ready to respond use: msg --from %s %s --reply-to %s 'your return message'} {Confirm receipt with: msg --ack %s}",
+			senderInfo, message, targetAgent.Name, senderInfo, ackID, ackID)
 	}
 
-	// Send message
+	// Non-tmux terminals have their own delivery CLIs
+	if isTerminalPane(paneID) {
+		return sendTerminalMessage(paneID, formattedMessage)
+	}
+
+	// Re-detect the pane's occupant just before injecting - the pane may
+	// have been reused for a shell or another program since it was resolved,
+	// and keystrokes into the wrong program become shell commands
+	if err := verifyPaneAgent(paneID, targetAgent.AgentType); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: aborting delivery to pane %s: %v\n", paneID, err)
+		return false
+	}
+
+	// Wait for the agent to stop generating output before injecting keys
+	waitForPaneIdle(paneID)
+
+	// Typewriter delivery trickles the keys in for TUIs that drop
+	// characters when a long string arrives at once
+	if targetAgent.Delivery == "typewriter" {
+		return typewriterTmuxMessage(paneID, formattedMessage)
+	}
+
+	return injectTmuxMessage(paneID, formattedMessage)
+}
+
+// verifyPaneAgent re-detects what the pane is running at send time and
+// errors when it no longer matches the expected agent type
+func verifyPaneAgent(paneID, agentType string) error {
+	cmd := exec.Command("tmux", "display-message", "-p", "-t", paneID, "#{pane_current_command}:#{pane_pid}")
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("pane no longer exists")
+	}
+
+	parts := strings.SplitN(strings.TrimSpace(string(output)), ":", 2)
+	pane := Pane{ID: paneID, Command: parts[0]}
+	if len(parts) == 2 {
+		pane.PID = parts[1]
+	}
+
+	if paneMatchesAgentType(pane, agentType) {
+		return nil
+	}
+	return fmt.Errorf("pane now runs %q, expected a %s agent", pane.Command, agentType)
+}
+
+// typewriterTmuxMessage types a formatted message into a tmux pane in small
+// chunks with a configurable delay between them, then submits it
+func typewriterTmuxMessage(paneID, formattedMessage string) bool {
+	config := loadConfig()
+	delay := time.Duration(config.TypewriterDelayMs) * time.Millisecond
+
+	runes := []rune(formattedMessage)
+	for start := 0; start < len(runes); start += config.TypewriterChunk {
+		end := start + config.TypewriterChunk
+		if end > len(runes) {
+			end = len(runes)
+		}
+		cmd := exec.Command("tmux", "send-keys", "-t", paneID, "-l", string(runes[start:end]))
+		if err := cmd.Run(); err != nil {
+			return false
+		}
+		time.Sleep(delay)
+	}
+
+	// Staggered Enter presses for reliability
+	time.Sleep(100 * time.Millisecond)
+	exec.Command("tmux", "send-keys", "-t", paneID, "C-m").Run()
+
+	time.Sleep(100 * time.Millisecond)
+	exec.Command("tmux", "send-keys", "-t", paneID, "C-m").Run()
+
+	return true
+}
+
+// injectTmuxMessage types a formatted message into a tmux pane and submits it
+func injectTmuxMessage(paneID, formattedMessage string) bool {
 	cmd := exec.Command("tmux", "send-keys", "-t", paneID, formattedMessage)
 	if err := cmd.Run(); err != nil {
 		return false
@@ -356,13 +863,13 @@ func getCurrentPaneInfo(registry []RegistryEntry) string {
 	agentType := detectAgentType(currentCmd)
 	if agentType == "unknown" && currentCmd == "node" {
 		// For node processes, need deeper detection
-		// Get the current pane ID
-		cmd = exec.Command("tmux", "display-message", "-p", "#{session_name}:#{window_index}.#{pane_index}")
-		paneOutput, _ := cmd.Output()
-		paneID := strings.TrimSpace(string(paneOutput))
-		if paneID != "" {
+		// Get the current pane's root PID
+		cmd = exec.Command("tmux", "display-message", "-p", "#{pane_pid}")
+		pidOutput, _ := cmd.Output()
+		panePID := strings.TrimSpace(string(pidOutput))
+		if panePID != "" {
 			// Try to detect what node process is actually running
-			agentType = detectNodeAgent(paneID)
+			agentType = detectNodeAgent(panePID)
 			// If still unknown, check if we're Claude (common case)
 			if agentType == "unknown" {
 				// Check if claude is running in this process tree
@@ -414,7 +921,8 @@ func detectSenderFromRegistry(registry []RegistryEntry) string {
 }
 
 func showStatus() {
-	fmt.Println("=== MESSAGING SYSTEM STATUS ===\n")
+	fmt.Println("=== MESSAGING SYSTEM STATUS ===")
+	fmt.Println()
 
 	// Load and show registry
 	registry := loadRegistry()
@@ -442,7 +950,7 @@ func showStatus() {
 		for _, pane := range panes {
 			agentType := detectAgentType(pane.Command)
 			if pane.Command == "node" {
-				detected := detectNodeAgent(pane.ID)
+				detected := detectNodeAgent(pane.PID)
 				if detected != "unknown" {
 					agentType = detected
 				}
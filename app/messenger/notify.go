@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+// Optional desktop notifications: with notify: true in config.yaml, every
+// successfully logged delivery also raises a desktop notification so the
+// human operator sees inter-agent traffic without watching the TUI. Uses
+// osascript on macOS and notify-send on Linux, falling back to an OSC 9
+// terminal notification when neither is available.
+
+const notifyBodyLimit = 120
+
+// notifyDelivery raises a notification for one delivered message; failures
+// are ignored - a missing notifier must never break delivery
+func notifyDelivery(sender, receiver, message string) {
+	if !loadConfig().Notify {
+		return
+	}
+
+	title := fmt.Sprintf("%s → %s", sender, receiver)
+	body := message
+	if len(body) > notifyBodyLimit {
+		body = body[:notifyBodyLimit] + "…"
+	}
+
+	if runtime.GOOS == "darwin" {
+		script := fmt.Sprintf("display notification %q with title %q", body, title)
+		exec.Command("osascript", "-e", script).Start()
+		return
+	}
+
+	if _, err := exec.LookPath("notify-send"); err == nil {
+		exec.Command("notify-send", title, body).Start()
+		return
+	}
+
+	// OSC 9: terminals like WezTerm and kitty surface this as a
+	// desktop notification
+	fmt.Fprintf(os.Stderr, "\x1b]9;%s: %s\x07", title, body)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// runSent handles `msg --sent [--failed]`, listing the recent outgoing
+// messages of the agent in the current directory with delivery status
+func runSent(args []string) {
+	failedOnly := false
+	for _, arg := range args {
+		if arg == "--failed" {
+			failedOnly = true
+		}
+	}
+
+	registry := loadRegistry()
+	if registry == nil {
+		os.Exit(1)
+	}
+
+	sender := detectSenderFromRegistry(registry)
+	if sender == "" || sender == "unknown" {
+		fmt.Fprintf(os.Stderr, "Error: could not determine sender - run from a registered agent directory\n")
+		os.Exit(1)
+	}
+
+	if db == nil {
+		fmt.Fprintf(os.Stderr, "Error: database not initialized\n")
+		os.Exit(1)
+	}
+
+	query := `
+		SELECT receiver_name, message, datetime(sent_at, 'localtime'), COALESCE(status, 'sent'), COALESCE(compressed, 0)
+		FROM messages
+		WHERE sender_name = ?`
+	if failedOnly {
+		query += ` AND status = 'failed'`
+	}
+	query += ` ORDER BY sent_at DESC LIMIT 20`
+
+	rows, err := db.Query(query, sender)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		var receiver, message, sentAt, status string
+		var compressed int
+		if err := rows.Scan(&receiver, &message, &sentAt, &status, &compressed); err != nil {
+			continue
+		}
+		message = decompressBody(message, compressed)
+
+		marker := "✓"
+		if status == "failed" {
+			marker = "✗"
+		}
+		if len(message) > 60 {
+			message = message[:57] + "..."
+		}
+		fmt.Printf("[%s] %s → %-16s %s\n", sentAt, marker, receiver, message)
+		count++
+	}
+
+	if count == 0 {
+		if failedOnly {
+			fmt.Printf("No failed messages from %s\n", sender)
+		} else {
+			fmt.Printf("No messages from %s\n", sender)
+		}
+	}
+}
+
+// logFailedDispatch records a delivery that errored out so `msg --sent
+// --failed` can report it. No-op without a detectable sender.
+func logFailedDispatch(senderName string, target *RegistryEntry, message string, registry []RegistryEntry) {
+	sender := senderName
+	if sender == "" {
+		sender = detectSenderFromRegistry(registry)
+	}
+	if sender == "" || sender == "unknown" {
+		return
+	}
+
+	var senderDir string
+	for _, agent := range registry {
+		if agent.Name == sender {
+			senderDir = agent.Directory
+			break
+		}
+	}
+
+	LogFailedMessage(sender, senderDir, target.Name, target.Directory, message)
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// windowsProcessSnapshot fills a snapshot using wmic since ps isn't available
+// on native Windows. Output is CSV: Node,CommandLine,ParentProcessId,ProcessId.
+func windowsProcessSnapshot(snapshot *processSnapshot) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "wmic", "process", "get",
+		"CommandLine,ParentProcessId,ProcessId", "/format:csv")
+	output, err := cmd.Output()
+	if err != nil {
+		return
+	}
+
+	for _, line := range strings.Split(string(output), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "Node,") {
+			continue
+		}
+
+		// CommandLine may itself contain commas, so split from the right:
+		// the last two fields are ParentProcessId and ProcessId
+		fields := strings.Split(line, ",")
+		if len(fields) < 4 {
+			continue
+		}
+		pid := strings.TrimSpace(fields[len(fields)-1])
+		ppid := strings.TrimSpace(fields[len(fields)-2])
+		command := strings.Join(fields[1:len(fields)-2], ",")
+		if pid == "" {
+			continue
+		}
+
+		snapshot.commands[pid] = command
+		snapshot.children[ppid] = append(snapshot.children[ppid], pid)
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// Plugin subcommands: executables in ~/.slaygent/plugins may declare msg
+// subcommand names in their `describe` manifest; `msg --<name> [args...]`
+// dispatches to `<plugin> run <name> [args...]` with stdio passed through.
+// Discovery mirrors the TUI's plugin loader.
+
+// pluginManifest is the subset of the describe manifest msg cares about
+type pluginManifest struct {
+	Name     string   `json:"name"`
+	Commands []string `json:"commands"`
+}
+
+// tryPluginCommand looks for an installed plugin providing the named
+// subcommand and runs it. Returns false when no plugin claims the name.
+func tryPluginCommand(name string, args []string) bool {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return false
+	}
+
+	dir := filepath.Join(home, ".slaygent", "plugins")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.Mode()&0111 == 0 {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		output, err := exec.Command(path, "describe").Output()
+		if err != nil {
+			continue
+		}
+
+		var manifest pluginManifest
+		if err := json.Unmarshal(output, &manifest); err != nil {
+			continue
+		}
+
+		for _, cmd := range manifest.Commands {
+			if cmd == name {
+				run := exec.Command(path, append([]string{"run", name}, args...)...)
+				run.Stdin = os.Stdin
+				run.Stdout = os.Stdout
+				run.Stderr = os.Stderr
+				if err := run.Run(); err != nil {
+					os.Exit(1)
+				}
+				return true
+			}
+		}
+	}
+	return false
+}
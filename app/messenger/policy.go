@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Message policies from the "policy" section of config.yaml: a maximum body
+// length, banned content patterns, and per-agent recipient allowlists. The
+// messenger enforces them before delivery, so a sandboxed agent can be kept
+// from messaging production-machine agents entirely.
+
+type msgPolicy struct {
+	MaxLength         int                 `yaml:"max_length,omitempty"`         // longest allowed message body, 0 = unlimited
+	BannedPatterns    []string            `yaml:"banned_patterns,omitempty"`    // regexes that block a message when matched
+	AllowedRecipients map[string][]string `yaml:"allowed_recipients,omitempty"` // sender -> permitted receivers; absent sender = unrestricted
+}
+
+// checkPolicy returns an error describing the first violated policy, or nil
+// when the message may be sent. Human senders are exempt from the recipient
+// allowlist but not from length and content limits.
+func checkPolicy(policy msgPolicy, sender, receiver, message string, human bool) error {
+	if policy.MaxLength > 0 && len(message) > policy.MaxLength {
+		return fmt.Errorf("message is %d characters, policy allows %d", len(message), policy.MaxLength)
+	}
+
+	for _, pattern := range policy.BannedPatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			// A broken pattern shouldn't silently disable the policy
+			return fmt.Errorf("banned_patterns entry %q is not a valid regex: %v", pattern, err)
+		}
+		if re.MatchString(message) {
+			return fmt.Errorf("message matches banned pattern %q", pattern)
+		}
+	}
+
+	if human || sender == "" {
+		return nil
+	}
+	allowed, restricted := policy.AllowedRecipients[sender]
+	if !restricted {
+		return nil
+	}
+	for _, name := range allowed {
+		if name == receiver {
+			return nil
+		}
+	}
+	return fmt.Errorf("policy does not allow %s to message %s", sender, receiver)
+}
@@ -0,0 +1,56 @@
+package main
+
+import "strings"
+
+// Canned quick replies sent with `msg <agent> --quick <name>`. The defaults
+// cover the usual coordination beats; users can add or override entries in
+// ~/.slaygent/config.yaml under quick_replies.
+
+type quickReply struct {
+	Name string `yaml:"name"`
+	Text string `yaml:"text"`
+}
+
+var defaultQuickReplies = []quickReply{
+	{Name: "ack", Text: "Acknowledged."},
+	{Name: "onit", Text: "On it."},
+	{Name: "blocked", Text: "Blocked, need input."},
+}
+
+// quickReplies returns the user's configured entries followed by any
+// defaults they have not overridden
+func quickReplies() []quickReply {
+	replies := loadConfig().QuickReplies
+	for _, def := range defaultQuickReplies {
+		overridden := false
+		for _, r := range replies {
+			if r.Name == def.Name {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			replies = append(replies, def)
+		}
+	}
+	return replies
+}
+
+// quickReplyText resolves a quick reply name, "" when unknown
+func quickReplyText(name string) string {
+	for _, r := range quickReplies() {
+		if r.Name == name {
+			return r.Text
+		}
+	}
+	return ""
+}
+
+// quickReplyNames lists the available names for error messages
+func quickReplyNames() string {
+	var names []string
+	for _, r := range quickReplies() {
+		names = append(names, r.Name)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Redaction of likely secrets in message bodies before they are written to
+// messages.db. Delivery itself is untouched - only the logged copy is
+// masked. Enabled with redact_secrets: true in ~/.slaygent/config.yaml;
+// redact_allowlist entries are substrings that exempt a match (e.g. known
+// test fixtures).
+
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`sk-[A-Za-z0-9_-]{20,}`),              // API keys (OpenAI/Anthropic style)
+	regexp.MustCompile(`gh[pousr]_[A-Za-z0-9]{36,}`),         // GitHub tokens
+	regexp.MustCompile(`AKIA[0-9A-Z]{16}`),                   // AWS access key IDs
+	regexp.MustCompile(`xox[baprs]-[A-Za-z0-9-]{10,}`),       // Slack tokens
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`), // PEM private keys
+	regexp.MustCompile(`(?i)(api[_-]?key|token|secret|password)\s*[=:]\s*['"]?[A-Za-z0-9_/+.-]{8,}`),
+}
+
+// redactSecrets masks likely secrets in a message body, leaving
+// allowlisted matches alone. Returns the body unchanged when the feature
+// is disabled.
+func redactSecrets(message string) string {
+	config := loadConfig()
+	if !config.RedactSecrets {
+		return message
+	}
+
+	for _, pattern := range secretPatterns {
+		message = pattern.ReplaceAllStringFunc(message, func(match string) string {
+			for _, allowed := range config.RedactAllowlist {
+				if allowed != "" && strings.Contains(match, allowed) {
+					return match
+				}
+			}
+			return "[REDACTED]"
+		})
+	}
+	return message
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// Conversation status tagging: `msg --set-status <agent> <status>` lets an
+// agent mark its conversation with another agent as blocked, done, or
+// in-progress. The TUI renders the status as a colored badge in the
+// conversation list, turning it into a lightweight work board.
+
+var validConversationStatuses = map[string]bool{
+	"blocked":     true,
+	"done":        true,
+	"in-progress": true,
+	"clear":       true, // removes the badge
+}
+
+// runSetStatus handles `msg --set-status <agent> <status>`
+func runSetStatus(args []string) {
+	if len(args) < 2 {
+		fmt.Fprintln(os.Stderr, "Usage: msg --set-status <agent_name> blocked|done|in-progress|clear")
+		os.Exit(1)
+	}
+	other := args[0]
+	status := args[1]
+	if !validConversationStatuses[status] {
+		fmt.Fprintf(os.Stderr, "Error: unknown status %q (use blocked, done, in-progress, or clear)\n", status)
+		os.Exit(1)
+	}
+	if status == "clear" {
+		status = ""
+	}
+
+	sender := detectSenderFromRegistry(loadRegistry())
+	if sender == "" || sender == "unknown" {
+		fmt.Fprintln(os.Stderr, "Error: could not determine sender (run from a registered agent's directory)")
+		os.Exit(1)
+	}
+
+	updated, err := SetConversationStatus(sender, other, status)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if updated == 0 {
+		fmt.Fprintf(os.Stderr, "No conversation found between %s and %s\n", sender, other)
+		os.Exit(1)
+	}
+
+	if status == "" {
+		fmt.Printf("Cleared status of conversation %s ↔ %s\n", sender, other)
+	} else {
+		fmt.Printf("Marked conversation %s ↔ %s as %s\n", sender, other, status)
+	}
+	LogEvent("conversation_status", fmt.Sprintf("%s ↔ %s: %s", sender, other, status))
+}
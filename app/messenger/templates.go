@@ -0,0 +1,59 @@
+package main
+
+import "strings"
+
+// Coordination templates sent with `msg <agent> --template <name>`. The
+// defaults cover the common inter-agent patterns; users can add or override
+// entries in ~/.slaygent/config.yaml under templates. Any free text after
+// the flag pair is appended to the expanded body, so the <placeholders> can
+// be filled in inline.
+
+type messageTemplate struct {
+	Name string `yaml:"name"`
+	Text string `yaml:"text"`
+}
+
+var defaultTemplates = []messageTemplate{
+	{Name: "handoff", Text: "HANDOFF - Pausing work on: <task>. Current state: <state>. Next steps: <next>. Relevant files: <files>."},
+	{Name: "review", Text: "REVIEW REQUEST - Please review: <branch/files>. Focus areas: <focus>. Known rough edges: <issues>."},
+	{Name: "conflict", Text: "MERGE CONFLICT - My changes on <branch> conflict with yours in: <files>. Proposed resolution: <plan>. Please confirm before I proceed."},
+	{Name: "testfail", Text: "TEST FAILURE - Failing: <test> on <branch>. Error: <error>. Suspected cause: <cause>."},
+}
+
+// messageTemplates returns the user's configured entries followed by any
+// defaults they have not overridden
+func messageTemplates() []messageTemplate {
+	templates := loadConfig().Templates
+	for _, def := range defaultTemplates {
+		overridden := false
+		for _, t := range templates {
+			if t.Name == def.Name {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			templates = append(templates, def)
+		}
+	}
+	return templates
+}
+
+// templateText resolves a template name, "" when unknown
+func templateText(name string) string {
+	for _, t := range messageTemplates() {
+		if t.Name == name {
+			return t.Text
+		}
+	}
+	return ""
+}
+
+// templateNames lists the available names for error messages
+func templateNames() string {
+	var names []string
+	for _, t := range messageTemplates() {
+		names = append(names, t.Name)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Delivery backends for agents running in WezTerm or kitty instead of tmux.
+// The TUI registers these panes with IDs prefixed "wezterm:<id>" / "kitty:<id>".
+
+// findTerminalPane searches WezTerm and kitty for the agent when no tmux pane
+// matches, returning a Pane whose ID carries the backend prefix.
+func findTerminalPane(agent *RegistryEntry) *Pane {
+	if pane := findWezTermPane(agent); pane != nil {
+		return pane
+	}
+	return findKittyPane(agent)
+}
+
+func findWezTermPane(agent *RegistryEntry) *Pane {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "wezterm", "cli", "list", "--format", "json")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+
+	var panes []struct {
+		PaneID int    `json:"pane_id"`
+		Title  string `json:"title"`
+		CWD    string `json:"cwd"`
+	}
+	if err := json.Unmarshal(output, &panes); err != nil {
+		return nil
+	}
+
+	for _, pane := range panes {
+		directory := pane.CWD
+		if parsed, err := url.Parse(pane.CWD); err == nil && parsed.Path != "" {
+			directory = parsed.Path
+		}
+		if !strings.HasPrefix(directory, agent.Directory) {
+			continue
+		}
+		if detectAgentType(pane.Title) == agent.AgentType {
+			return &Pane{
+				ID:        "wezterm:" + strconv.Itoa(pane.PaneID),
+				Command:   pane.Title,
+				Directory: directory,
+			}
+		}
+	}
+
+	return nil
+}
+
+func findKittyPane(agent *RegistryEntry) *Pane {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "kitten", "@", "ls")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+
+	var osWindows []struct {
+		Tabs []struct {
+			Windows []struct {
+				ID                  int `json:"id"`
+				ForegroundProcesses []struct {
+					Cmdline []string `json:"cmdline"`
+					CWD     string   `json:"cwd"`
+				} `json:"foreground_processes"`
+			} `json:"windows"`
+		} `json:"tabs"`
+	}
+	if err := json.Unmarshal(output, &osWindows); err != nil {
+		return nil
+	}
+
+	for _, osWindow := range osWindows {
+		for _, tab := range osWindow.Tabs {
+			for _, window := range tab.Windows {
+				for _, proc := range window.ForegroundProcesses {
+					if !strings.HasPrefix(proc.CWD, agent.Directory) {
+						continue
+					}
+					if detectAgentType(strings.Join(proc.Cmdline, " ")) == agent.AgentType {
+						return &Pane{
+							ID:        "kitty:" + strconv.Itoa(window.ID),
+							Command:   strings.Join(proc.Cmdline, " "),
+							Directory: proc.CWD,
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// sendTerminalMessage delivers a formatted message to a WezTerm or kitty pane
+// using each terminal's remote-control CLI. Returns false for unknown prefixes.
+func sendTerminalMessage(paneID, formattedMessage string) bool {
+	switch {
+	case strings.HasPrefix(paneID, "wezterm:"):
+		id := strings.TrimPrefix(paneID, "wezterm:")
+		cmd := exec.Command("wezterm", "cli", "send-text", "--no-paste", "--pane-id", id, formattedMessage+"\n")
+		return cmd.Run() == nil
+
+	case strings.HasPrefix(paneID, "kitty:"):
+		id := strings.TrimPrefix(paneID, "kitty:")
+		cmd := exec.Command("kitten", "@", "send-text", "--match", "id:"+id, formattedMessage+"\r")
+		return cmd.Run() == nil
+	}
+
+	return false
+}
+
+// isTerminalPane reports whether a pane ID belongs to a non-tmux backend
+func isTerminalPane(paneID string) bool {
+	return strings.HasPrefix(paneID, "wezterm:") || strings.HasPrefix(paneID, "kitty:")
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// Reply watchdog: a sender attaches `--expect-reply 15m` to a send, which
+// records an expectation in the database. The expectation is fulfilled
+// automatically when the recipient's reply is logged. `msg --watchdog`
+// (run periodically from cron or a wrapper) re-pings recipients of overdue
+// expectations and notifies a human channel when SLAYGENT_NOTIFY_CMD is set.
+
+// runWatchdog handles `msg --watchdog`, escalating overdue expectations
+func runWatchdog() {
+	registry := loadRegistry()
+	if registry == nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to load registry\n")
+		os.Exit(1)
+	}
+
+	overdue, err := OverdueReplies()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(overdue) == 0 {
+		fmt.Println("No overdue replies")
+		return
+	}
+
+	for _, exp := range overdue {
+		fmt.Printf("Overdue: %s has not replied to %s (due %s)\n",
+			exp.Replier, exp.Expecter, exp.Deadline.Local().Format("15:04:05"))
+
+		// Re-ping the recipient through the normal delivery path
+		var target *RegistryEntry
+		for _, agent := range registry {
+			if agent.Name == exp.Replier {
+				target = &agent
+				break
+			}
+		}
+		if target != nil {
+			pane := findAgentPane(target)
+			if pane == nil {
+				pane = findTerminalPane(target)
+			}
+			if pane != nil {
+				reminder := fmt.Sprintf(
+					"{Reminder from slaygent watchdog} %s is still waiting for your reply. {Respond with: msg --from %s %s 'your return message'}",
+					exp.Expecter, exp.Replier, exp.Expecter)
+				if isTerminalPane(pane.ID) {
+					sendTerminalMessage(pane.ID, reminder)
+				} else {
+					injectTmuxMessage(pane.ID, reminder)
+				}
+				fmt.Printf("  re-pinged %s\n", exp.Replier)
+			}
+		}
+
+		// Notify a human channel when one is configured
+		if notifyCmd := os.Getenv("SLAYGENT_NOTIFY_CMD"); notifyCmd != "" {
+			detail := fmt.Sprintf("slaygent: %s has not replied to %s (overdue since %s)",
+				exp.Replier, exp.Expecter, exp.Deadline.Format(time.RFC3339))
+			exec.Command("sh", "-c", notifyCmd+" "+fmt.Sprintf("%q", detail)).Run()
+		}
+
+		MarkEscalated(exp.ID)
+		LogEvent("reply_overdue", fmt.Sprintf("%s owes %s a reply", exp.Replier, exp.Expecter))
+	}
+}
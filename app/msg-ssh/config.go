@@ -0,0 +1,47 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// User configuration from ~/.slaygent/config.yaml, the file shared by slay,
+// msg and msg-ssh - each binary parses just the keys it needs. msg-ssh reads
+// "ssh_timeout_seconds": how long remote delivery and registry queries may
+// take before giving up (default 10).
+
+type sshConfig struct {
+	SSHTimeoutSeconds int `yaml:"ssh_timeout_seconds,omitempty"`
+}
+
+// loadConfig reads the shared config file, returning defaults when absent
+// or unparsable
+func loadConfig() sshConfig {
+	config := sshConfig{SSHTimeoutSeconds: 10}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return config
+	}
+
+	data, err := os.ReadFile(filepath.Join(home, ".slaygent", "config.yaml"))
+	if err != nil {
+		return config
+	}
+
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return sshConfig{SSHTimeoutSeconds: 10}
+	}
+	if config.SSHTimeoutSeconds <= 0 {
+		config.SSHTimeoutSeconds = 10
+	}
+	return config
+}
+
+// sshTimeout returns the configured SSH operation timeout
+func sshTimeout() time.Duration {
+	return time.Duration(loadConfig().SSHTimeoutSeconds) * time.Second
+}
@@ -1,3 +1,5 @@
 module msg-ssh
 
-go 1.23.0
\ No newline at end of file
+go 1.23.0
+
+require gopkg.in/yaml.v3 v3.0.1
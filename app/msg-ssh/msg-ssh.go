@@ -42,6 +42,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if os.Args[1] == "--version" {
+		printVersion()
+		os.Exit(0)
+	}
+
 	if os.Args[1] == "--status" {
 		showCrossMachineStatus()
 		os.Exit(0)
@@ -256,7 +261,7 @@ func sendRemoteMessage(sender, receiver, message, machine string, sshRegistry []
 
 	// Execute SSH command to send message
 	fullCmd := append(sshParts, remoteMsgCmd)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), sshTimeout())
 	defer cancel()
 
 	cmd := exec.CommandContext(ctx, fullCmd[0], fullCmd[1:]...)
@@ -298,7 +303,7 @@ func queryRemoteAgents(conn SSHConnection) []RegistryEntry {
 	remoteCmd := "cat ~/.slaygent/registry.json 2>/dev/null || echo '[]'"
 	fullCmd := append(sshParts, remoteCmd)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), sshTimeout())
 	defer cancel()
 
 	cmd := exec.CommandContext(ctx, fullCmd[0], fullCmd[1:]...)
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var db *sql.DB
+
+func InitDB() error {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+
+	slaygentDir := filepath.Join(home, ".slaygent")
+	os.MkdirAll(slaygentDir, 0755)
+
+	dbPath := filepath.Join(slaygentDir, "messages.db")
+
+	db, err = sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+
+	// Create tables if they don't exist (same schema as the msg tool)
+	schema := `
+	CREATE TABLE IF NOT EXISTS conversations (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		agent1_name TEXT NOT NULL,
+		agent1_dir TEXT NOT NULL,
+		agent2_name TEXT NOT NULL,
+		agent2_dir TEXT NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		last_message_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE(agent1_name, agent1_dir, agent2_name, agent2_dir)
+	);
+
+	CREATE TABLE IF NOT EXISTS messages (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		conversation_id INTEGER NOT NULL,
+		sender_name TEXT NOT NULL,
+		sender_dir TEXT NOT NULL,
+		receiver_name TEXT NOT NULL,
+		receiver_dir TEXT NOT NULL,
+		message TEXT NOT NULL,
+		sent_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (conversation_id) REFERENCES conversations(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_messages_sent_at ON messages(sent_at);
+	`
+
+	_, err = db.Exec(schema)
+	if err != nil {
+		return err
+	}
+
+	// Run cleanup on startup
+	CleanupOldMessages()
+
+	return nil
+}
+
+func getOrCreateConversation(senderName, senderDir, receiverName, receiverDir string) (int64, error) {
+	// Sort agents alphabetically for consistent conversation grouping
+	agents := []struct {
+		Name string
+		Dir  string
+	}{
+		{senderName, senderDir},
+		{receiverName, receiverDir},
+	}
+
+	sort.Slice(agents, func(i, j int) bool {
+		if agents[i].Name == agents[j].Name {
+			return agents[i].Dir < agents[j].Dir
+		}
+		return agents[i].Name < agents[j].Name
+	})
+
+	var conversationID int64
+	err := db.QueryRow(`
+		SELECT id FROM conversations
+		WHERE agent1_name = ? AND agent1_dir = ?
+		AND agent2_name = ? AND agent2_dir = ?`,
+		agents[0].Name, agents[0].Dir,
+		agents[1].Name, agents[1].Dir,
+	).Scan(&conversationID)
+
+	if err == sql.ErrNoRows {
+		result, err := db.Exec(`
+			INSERT INTO conversations (agent1_name, agent1_dir, agent2_name, agent2_dir)
+			VALUES (?, ?, ?, ?)`,
+			agents[0].Name, agents[0].Dir,
+			agents[1].Name, agents[1].Dir,
+		)
+		if err != nil {
+			return 0, err
+		}
+		conversationID, err = result.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+	} else if err != nil {
+		return 0, err
+	}
+
+	_, err = db.Exec(`
+		UPDATE conversations
+		SET last_message_at = CURRENT_TIMESTAMP
+		WHERE id = ?`,
+		conversationID,
+	)
+
+	return conversationID, err
+}
+
+func LogMessage(sender, senderDir, receiver, receiverDir, message string) error {
+	if db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	conversationID, err := getOrCreateConversation(sender, senderDir, receiver, receiverDir)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec(`
+		INSERT INTO messages (conversation_id, sender_name, sender_dir, receiver_name, receiver_dir, message)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		conversationID, sender, senderDir, receiver, receiverDir, message,
+	)
+
+	return err
+}
+
+func CleanupOldMessages() error {
+	if db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	// Delete messages older than 30 days
+	thirtyDaysAgo := time.Now().AddDate(0, 0, -30).Format("2006-01-02 15:04:05")
+
+	_, err := db.Exec(`
+		DELETE FROM messages
+		WHERE sent_at < ?`,
+		thirtyDaysAgo,
+	)
+
+	return err
+}
+
+func CloseDB() {
+	if db != nil {
+		db.Close()
+	}
+}
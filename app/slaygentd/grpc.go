@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"slaygentd/pb"
+)
+
+// defaultGRPCAddr is where the daemon serves its gRPC API. Override with
+// SLAYGENTD_GRPC_ADDR. Loopback only - this is a local API.
+const defaultGRPCAddr = "127.0.0.1:7466"
+
+// grpcServer implements the Slaygent service on top of the daemon state
+type grpcServer struct {
+	pb.UnimplementedSlaygentServer
+	daemon *daemon
+
+	mu          sync.Mutex
+	subscribers map[chan *pb.MessageEvent]string // channel -> agent filter ("" = all)
+}
+
+func newGRPCServer(d *daemon) *grpcServer {
+	return &grpcServer{
+		daemon:      d,
+		subscribers: make(map[chan *pb.MessageEvent]string),
+	}
+}
+
+// serveGRPC starts the gRPC listener in the background
+func (s *grpcServer) serveGRPC() error {
+	addr := os.Getenv("SLAYGENTD_GRPC_ADDR")
+	if addr == "" {
+		addr = defaultGRPCAddr
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("gRPC listen on %s: %w", addr, err)
+	}
+
+	server := grpc.NewServer()
+	pb.RegisterSlaygentServer(server, s)
+
+	fmt.Printf("slaygentd gRPC API on %s\n", addr)
+	go server.Serve(listener)
+	return nil
+}
+
+func (s *grpcServer) ListAgents(ctx context.Context, req *pb.ListAgentsRequest) (*pb.ListAgentsResponse, error) {
+	s.daemon.mu.Lock()
+	defer s.daemon.mu.Unlock()
+
+	resp := &pb.ListAgentsResponse{}
+	for _, agent := range s.daemon.registry.GetAgents() {
+		resp.Agents = append(resp.Agents, &pb.Agent{
+			Name:      agent.Name,
+			AgentType: agent.AgentType,
+			Directory: agent.Directory,
+			Machine:   agent.Machine,
+		})
+	}
+	return resp, nil
+}
+
+func (s *grpcServer) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.SendMessageResponse, error) {
+	s.daemon.mu.Lock()
+	var target *RegisteredAgent
+	agents := s.daemon.registry.GetAgents()
+	for i := range agents {
+		if agents[i].Name == req.Receiver {
+			target = &agents[i]
+			break
+		}
+	}
+	s.daemon.mu.Unlock()
+
+	if target == nil {
+		return &pb.SendMessageResponse{Error: fmt.Sprintf("agent %q not found in registry", req.Receiver)}, nil
+	}
+
+	if !deliverToPane(target, req.Sender, req.Message) {
+		return &pb.SendMessageResponse{Error: fmt.Sprintf("no live pane found for %q", req.Receiver)}, nil
+	}
+
+	if req.Sender != "" {
+		LogMessage(req.Sender, "grpc", target.Name, target.Directory, req.Message)
+	}
+
+	s.broadcast(&pb.MessageEvent{
+		Sender:     req.Sender,
+		Receiver:   req.Receiver,
+		Message:    req.Message,
+		SentAtUnix: time.Now().Unix(),
+	})
+
+	return &pb.SendMessageResponse{Delivered: true}, nil
+}
+
+func (s *grpcServer) StreamMessages(req *pb.StreamMessagesRequest, stream grpc.ServerStreamingServer[pb.MessageEvent]) error {
+	events := make(chan *pb.MessageEvent, 16)
+
+	s.mu.Lock()
+	s.subscribers[events] = req.Agent
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.subscribers, events)
+		s.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// broadcastLogged lets the unix-socket API surface logged messages to stream
+// subscribers too, so msg deliveries routed through the daemon show up live
+func (s *grpcServer) broadcastLogged(sender, receiver, message string) {
+	s.broadcast(&pb.MessageEvent{
+		Sender:     sender,
+		Receiver:   receiver,
+		Message:    message,
+		SentAtUnix: time.Now().Unix(),
+	})
+}
+
+// broadcast fans an event out to all stream subscribers, honoring filters.
+// Slow subscribers are skipped rather than blocking delivery.
+func (s *grpcServer) broadcast(event *pb.MessageEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for events, filter := range s.subscribers {
+		if filter != "" && filter != event.Sender && filter != event.Receiver {
+			continue
+		}
+		select {
+		case events <- event:
+		default:
+		}
+	}
+}
+
+// deliverToPane finds the agent's tmux pane and injects a formatted message
+func deliverToPane(agent *RegisteredAgent, sender, message string) bool {
+	var pane *Pane
+	for _, p := range getTmuxPanes() {
+		if p.AgentType == agent.AgentType && strings.HasPrefix(p.Directory, agent.Directory) {
+			pane = &p
+			break
+		}
+	}
+	if pane == nil {
+		return false
+	}
+
+	formatted := message
+	if sender != "" {
+		formatted = fmt.Sprintf(
+			"{Receiving msg from: %s} \"%s\" {When ready to respond use: msg --from %s %s 'your return message'}",
+			sender, message, agent.Name, sender)
+	}
+
+	if err := exec.Command("tmux", "send-keys", "-t", pane.ID, formatted).Run(); err != nil {
+		return false
+	}
+
+	// Staggered Enter presses for reliability
+	time.Sleep(100 * time.Millisecond)
+	exec.Command("tmux", "send-keys", "-t", pane.ID, "C-m").Run()
+
+	time.Sleep(100 * time.Millisecond)
+	exec.Command("tmux", "send-keys", "-t", pane.ID, "C-m").Run()
+
+	return true
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"net"
+	"os/exec"
+	"time"
+)
+
+// tmux hooks: instead of relying solely on the 3s poll, the daemon installs
+// global hooks that run `slaygentd --poke` whenever panes appear or die, so
+// the registry reflects pane lifecycle changes instantly.
+
+// watchedHooks are the tmux events that change the set of live panes
+var watchedHooks = []string{
+	"pane-exited",
+	"after-split-window",
+	"session-created",
+	"session-closed",
+}
+
+// hookIndex is the array slot the daemon claims on each event. Array-style
+// hooks ("pane-exited[97]") append alongside whatever the user configured
+// instead of overwriting the bare hook; the index is arbitrary but fixed so
+// install and remove always target the same slot.
+const hookIndex = "[97]"
+
+// installTmuxHooks wires pane lifecycle events to `slaygentd --poke`.
+// Failures are non-fatal - the poll loop still covers missed events.
+func installTmuxHooks() {
+	for _, hook := range watchedHooks {
+		exec.Command("tmux", "set-hook", "-g", hook+hookIndex,
+			"run-shell 'slaygentd --poke'").Run()
+	}
+}
+
+// removeTmuxHooks clears only the daemon's hook slot on shutdown, leaving
+// any user-configured hooks on the same events untouched
+func removeTmuxHooks() {
+	for _, hook := range watchedHooks {
+		exec.Command("tmux", "set-hook", "-gu", hook+hookIndex).Run()
+	}
+}
+
+// pokeDaemon asks a running daemon to rescan panes immediately. Used by the
+// tmux hooks; exits silently when no daemon is running - hooks must never
+// block tmux.
+func pokeDaemon() {
+	conn, err := net.DialTimeout("unix", daemonSocketPath(), 2*time.Second)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	json.NewEncoder(conn).Encode(request{Op: "refresh"})
+	var resp response
+	json.NewDecoder(conn).Decode(&resp)
+}
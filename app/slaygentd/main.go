@@ -0,0 +1,239 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// slaygentd is a long-running daemon that owns registry state, watches tmux
+// for pane lifecycle changes, and maintains the message database. It exposes
+// a line-delimited JSON API on a local unix socket so the TUI and msg can act
+// as thin clients instead of racing each other over the registry file.
+
+const refreshInterval = 3 * time.Second
+
+type daemon struct {
+	mu       sync.Mutex
+	registry *Registry
+	panes    []Pane
+	grpcAPI  *grpcServer   // Set at startup; fans events out to stream subscribers
+	refresh  chan struct{} // Signaled by tmux hooks for an immediate rescan
+}
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "--version" {
+		printVersion()
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "--status" {
+		pingDaemon()
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "--poke" {
+		pokeDaemon()
+		return
+	}
+
+	registry, err := NewRegistry()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to load registry: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := InitDB(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: database initialization failed: %v\n", err)
+	}
+	defer CloseDB()
+
+	d := &daemon{registry: registry, refresh: make(chan struct{}, 1)}
+
+	socketPath := daemonSocketPath()
+	os.Remove(socketPath) // Clear stale socket from a previous run
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to listen on %s: %v\n", socketPath, err)
+		os.Exit(1)
+	}
+	defer listener.Close()
+	defer os.Remove(socketPath)
+
+	// Watch tmux for pane lifecycle changes in the background, with hooks
+	// poking the daemon so changes land without waiting for the next poll
+	go d.watchPanes()
+	installTmuxHooks()
+
+	// Serve the gRPC API (ListAgents, SendMessage, StreamMessages) so external
+	// tools can subscribe to live message streams instead of polling SQLite
+	d.grpcAPI = newGRPCServer(d)
+	if err := d.grpcAPI.serveGRPC(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	// Clean shutdown on SIGINT/SIGTERM removes the socket
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		removeTmuxHooks()
+		listener.Close()
+		os.Remove(socketPath)
+		CloseDB()
+		os.Exit(0)
+	}()
+
+	fmt.Printf("slaygentd listening on %s\n", socketPath)
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return // Listener closed during shutdown
+		}
+		go d.handleConnection(conn)
+	}
+}
+
+// daemonSocketPath returns the unix socket location under ~/.slaygent
+func daemonSocketPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "slaygentd.sock"
+	}
+	slaygentDir := filepath.Join(home, ".slaygent")
+	os.MkdirAll(slaygentDir, 0755)
+	return filepath.Join(slaygentDir, "slaygentd.sock")
+}
+
+// watchPanes polls tmux and keeps the registry in sync with live panes,
+// removing registrations whose agents have exited. tmux hooks cut the wait
+// short via the refresh channel so lifecycle changes land instantly.
+func (d *daemon) watchPanes() {
+	for {
+		panes := getTmuxPanes()
+
+		d.mu.Lock()
+		d.panes = panes
+		d.registry.SyncWithActive(panes)
+		d.mu.Unlock()
+
+		select {
+		case <-time.After(refreshInterval):
+		case <-d.refresh:
+		}
+	}
+}
+
+// request is a single line-delimited JSON API call
+type request struct {
+	Op        string `json:"op"` // list_agents, register, deregister, panes, log_message, ping
+	Name      string `json:"name,omitempty"`
+	AgentType string `json:"agent_type,omitempty"`
+	Directory string `json:"directory,omitempty"`
+	Machine   string `json:"machine,omitempty"`
+	Sender    string `json:"sender,omitempty"`
+	SenderDir string `json:"sender_dir,omitempty"`
+	Receiver  string `json:"receiver,omitempty"`
+	RecvDir   string `json:"receiver_dir,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+type response struct {
+	OK     bool        `json:"ok"`
+	Error  string      `json:"error,omitempty"`
+	Result interface{} `json:"result,omitempty"`
+}
+
+func (d *daemon) handleConnection(conn net.Conn) {
+	defer conn.Close()
+
+	decoder := json.NewDecoder(conn)
+	encoder := json.NewEncoder(conn)
+
+	for {
+		var req request
+		if err := decoder.Decode(&req); err != nil {
+			return // Client disconnected or sent garbage
+		}
+		encoder.Encode(d.handleRequest(req))
+	}
+}
+
+func (d *daemon) handleRequest(req request) response {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	switch req.Op {
+	case "ping":
+		return response{OK: true, Result: "pong"}
+
+	case "list_agents":
+		return response{OK: true, Result: d.registry.GetAgents()}
+
+	case "panes":
+		return response{OK: true, Result: d.panes}
+
+	case "refresh":
+		// Non-blocking - a rescan is already queued if the channel is full
+		select {
+		case d.refresh <- struct{}{}:
+		default:
+		}
+		return response{OK: true}
+
+	case "register":
+		machine := req.Machine
+		if machine == "" {
+			machine = "host"
+		}
+		if err := d.registry.RegisterWithMachine(req.Name, req.AgentType, req.Directory, machine); err != nil {
+			return response{Error: err.Error()}
+		}
+		return response{OK: true}
+
+	case "deregister":
+		machine := req.Machine
+		if machine == "" {
+			machine = "host"
+		}
+		if err := d.registry.DeregisterWithMachine(req.AgentType, req.Directory, machine); err != nil {
+			return response{Error: err.Error()}
+		}
+		return response{OK: true}
+
+	case "log_message":
+		if err := LogMessage(req.Sender, req.SenderDir, req.Receiver, req.RecvDir, req.Message); err != nil {
+			return response{Error: err.Error()}
+		}
+		if d.grpcAPI != nil {
+			d.grpcAPI.broadcastLogged(req.Sender, req.Receiver, req.Message)
+		}
+		return response{OK: true}
+	}
+
+	return response{Error: fmt.Sprintf("unknown op: %q", req.Op)}
+}
+
+// pingDaemon checks whether a daemon is running and reachable
+func pingDaemon() {
+	conn, err := net.DialTimeout("unix", daemonSocketPath(), 2*time.Second)
+	if err != nil {
+		fmt.Println("slaygentd is not running")
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	json.NewEncoder(conn).Encode(request{Op: "ping"})
+	var resp response
+	if err := json.NewDecoder(conn).Decode(&resp); err != nil || !resp.OK {
+		fmt.Println("slaygentd is not responding")
+		os.Exit(1)
+	}
+	fmt.Println("slaygentd is running")
+}
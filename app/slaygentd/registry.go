@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// RegisteredAgent is a simple registration with name, type, and directory
+type RegisteredAgent struct {
+	Name      string `json:"name"`       // User-given name
+	AgentType string `json:"agent_type"` // claude, opencode, coder, crush
+	Directory string `json:"directory"`  // Full working directory path
+	Machine   string `json:"machine"`    // Machine name (defaults to "host")
+}
+
+// Registry manages the registry.json file
+type Registry struct {
+	agents   []RegisteredAgent
+	filePath string
+}
+
+// NewRegistry creates or loads the registry
+func NewRegistry() (*Registry, error) {
+	home, err := os.UserHomeDir()
+	registryPath := "registry.json" // fallback to local
+	if err == nil {
+		slaygentDir := filepath.Join(home, ".slaygent")
+		os.MkdirAll(slaygentDir, 0755)
+		registryPath = filepath.Join(slaygentDir, "registry.json")
+	}
+
+	r := &Registry{
+		agents:   []RegisteredAgent{},
+		filePath: registryPath,
+	}
+
+	r.Load()
+	return r, nil
+}
+
+// RegisterWithMachine adds a new agent with a name and machine
+func (r *Registry) RegisterWithMachine(name, agentType, directory, machine string) error {
+	r.DeregisterWithMachine(agentType, directory, machine)
+
+	r.agents = append(r.agents, RegisteredAgent{
+		Name:      name,
+		AgentType: agentType,
+		Directory: directory,
+		Machine:   machine,
+	})
+
+	return r.Save()
+}
+
+// DeregisterWithMachine removes an agent by type, directory, and machine
+func (r *Registry) DeregisterWithMachine(agentType, directory, machine string) error {
+	filtered := []RegisteredAgent{}
+	for _, agent := range r.agents {
+		if !(agent.AgentType == agentType && agent.Directory == directory && agent.Machine == machine) {
+			filtered = append(filtered, agent)
+		}
+	}
+	r.agents = filtered
+	return r.Save()
+}
+
+// GetAgents returns all registered agents
+func (r *Registry) GetAgents() []RegisteredAgent {
+	return r.agents
+}
+
+// Load reads the registry from disk
+func (r *Registry) Load() error {
+	data, err := os.ReadFile(r.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // File doesn't exist yet, that's ok
+		}
+		return err
+	}
+
+	if err := json.Unmarshal(data, &r.agents); err != nil {
+		return err
+	}
+
+	// Migrate existing agents without Machine field to "host"
+	modified := false
+	for i := range r.agents {
+		if r.agents[i].Machine == "" {
+			r.agents[i].Machine = "host"
+			modified = true
+		}
+	}
+
+	if modified {
+		return r.Save()
+	}
+
+	return nil
+}
+
+// SyncWithActive removes host registry entries whose agents are gone
+func (r *Registry) SyncWithActive(panes []Pane) error {
+	activeSet := make(map[string]bool)
+	for _, pane := range panes {
+		activeSet[pane.AgentType+":"+pane.Directory] = true
+	}
+
+	filtered := []RegisteredAgent{}
+	for _, agent := range r.agents {
+		// Only host agents are tracked against the local tmux server
+		if agent.Machine != "host" || activeSet[agent.AgentType+":"+agent.Directory] {
+			filtered = append(filtered, agent)
+		}
+	}
+
+	if len(filtered) != len(r.agents) {
+		r.agents = filtered
+		return r.Save()
+	}
+	return nil
+}
+
+// Save writes the registry to disk
+func (r *Registry) Save() error {
+	data, err := json.MarshalIndent(r.agents, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.filePath, data, 0644)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"os/exec"
+	"strings"
+)
+
+// Pane is a tmux pane running a known AI agent
+type Pane struct {
+	ID        string `json:"id"` // session:window.pane
+	Directory string `json:"directory"`
+	AgentType string `json:"agent_type"`
+	Status    string `json:"status"` // active/idle
+}
+
+// getTmuxPanes lists panes running a known AI agent from the local tmux server
+func getTmuxPanes() []Pane {
+	cmd := exec.Command("tmux", "list-panes", "-a", "-F",
+		"#{session_name}:#{window_index}.#{pane_index}:#{pane_current_path}:#{pane_current_command}:#{?pane_active,active,idle}")
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+
+	var panes []Pane
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		parts := strings.Split(strings.TrimSpace(line), ":")
+		if len(parts) < 5 {
+			continue
+		}
+
+		agentType := detectAgentType(parts[3])
+		if agentType == "unknown" {
+			continue
+		}
+
+		panes = append(panes, Pane{
+			ID:        parts[0] + ":" + parts[1],
+			Directory: parts[2],
+			AgentType: agentType,
+			Status:    parts[4],
+		})
+	}
+
+	return panes
+}
+
+// detectAgentType analyzes a pane command to determine AI agent type
+func detectAgentType(command string) string {
+	command = strings.ToLower(command)
+
+	if strings.Contains(command, "claude") {
+		return "claude"
+	}
+	if strings.Contains(command, "opencode") {
+		return "opencode"
+	}
+	if strings.Contains(command, "coder") && !strings.Contains(command, "opencode") {
+		return "coder"
+	}
+	if strings.Contains(command, "crush") {
+		return "crush"
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,17 @@
+package main
+
+import "fmt"
+
+// Build metadata injected at release time via ldflags:
+//
+//	-X main.version=... -X main.commit=... -X main.buildDate=...
+var (
+	version   = "dev"
+	commit    = "none"
+	buildDate = "unknown"
+)
+
+// printVersion handles `slaygentd --version`
+func printVersion() {
+	fmt.Printf("slaygentd %s (commit %s, built %s)\n", version, commit, buildDate)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Efficiency audit overlay ('ctrl+t'): render counts, last Update duration
+// and subprocesses spawned by the last refresh, for tracking down refreshes
+// that fork dozens of tmux/ps processes per keystroke. Counters are global
+// because View and Update copy the model by value; the subprocess count is
+// approximate while concurrent source refreshes overlap.
+
+var (
+	renderCount     int64
+	subprocessSpawn int64
+)
+
+// countSubprocess records one spawned external process (tmux, ps, ssh)
+func countSubprocess() {
+	atomic.AddInt64(&subprocessSpawn, 1)
+}
+
+// takeSubprocessCount returns the spawns since the last call and resets
+func takeSubprocessCount() int64 {
+	return atomic.SwapInt64(&subprocessSpawn, 0)
+}
+
+// Update times the real update pass so the audit overlay can show how long
+// the last keystroke or message took to process
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	start := time.Now()
+	updated, cmd := m.update(msg)
+	if mm, ok := updated.(model); ok {
+		mm.lastUpdateDuration = time.Since(start)
+		return mm, cmd
+	}
+	return updated, cmd
+}
+
+// auditLine renders the one-line overlay appended below the active view
+func (m model) auditLine() string {
+	line := fmt.Sprintf("audit: %d renders • update %s • %d subprocesses last refresh",
+		atomic.LoadInt64(&renderCount),
+		m.lastUpdateDuration.Round(time.Microsecond),
+		m.lastRefreshSubprocs)
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("#D4AC0D")).Render(line)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"strings"
+)
+
+// Opt-in zero-touch roster: with auto_register: true in the config, newly
+// detected local agents inside auto_register_dirs (any directory when the
+// list is empty) are registered automatically with generated names, and
+// removed again after auto_deregister_grace_seconds once they disappear.
+
+// autoRegister names any unregistered local agents the config covers; rows
+// are the freshly scanned agent rows
+func (m model) autoRegister(rows [][]string) {
+	if !m.config.AutoRegister || m.registry == nil {
+		return
+	}
+	template := m.config.NameTemplate
+	if template == "" {
+		template = "{dirbase}-{type}"
+	}
+
+	for _, row := range rows {
+		if len(row) < 7 {
+			continue
+		}
+		agentType := row[2]     // AGENT column
+		fullDirectory := row[1] // DIRECTORY column (full path)
+		machine := row[5]       // MACHINE column
+		if !isRegistrableMachine(machine) || !autoRegisterDirMatch(m.config.AutoRegisterDirs, fullDirectory) {
+			continue
+		}
+		if m.registry.GetNameForPane(agentType, fullDirectory, machine, row[0]) != "" {
+			continue
+		}
+
+		// Same pane-binding rule as manual registration: bind when the
+		// type+directory already has a name
+		pane := ""
+		if m.registry.IsRegisteredWithMachine(agentType, fullDirectory, machine) {
+			pane = row[0]
+		}
+		name := suggestAgentName(template, agentType, fullDirectory, machine, m.nameTaken)
+		if name == "" {
+			continue
+		}
+		m.registry.RegisterAuto(name, agentType, fullDirectory, machine, pane)
+	}
+}
+
+// autoRegisterDirMatch reports whether directory is one of the configured
+// roots or inside one; an empty list matches everything
+func autoRegisterDirMatch(dirs []string, directory string) bool {
+	if len(dirs) == 0 {
+		return true
+	}
+	for _, dir := range dirs {
+		root := strings.TrimRight(dir, "/")
+		if directory == root || strings.HasPrefix(directory, root+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// `slay sync clean-backups [--older-than 30d] [--dry-run]` removes the
+// CLAUDE.md.backup files each sync run leaves next to every CLAUDE.md.
+// Without cleanup they accumulate indefinitely in registered repos.
+
+// runCleanBackups handles `slay sync clean-backups`
+func runCleanBackups(args []string) {
+	var olderThan time.Duration
+	dryRun := false
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--older-than":
+			if i+1 >= len(args) {
+				fmt.Fprintln(os.Stderr, "Error: --older-than requires a duration like 30d")
+				os.Exit(1)
+			}
+			i++
+			d, err := parseSinceDuration(args[i])
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			olderThan = d
+		case "--dry-run":
+			dryRun = true
+		default:
+			fmt.Fprintln(os.Stderr, "Usage: slay sync clean-backups [--older-than 30d] [--dry-run]")
+			os.Exit(1)
+		}
+	}
+
+	registry, err := NewRegistry()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	cutoff := time.Now().Add(-olderThan)
+	removed := 0
+	seen := make(map[string]bool)
+	for _, agent := range registry.GetAgents() {
+		if !isRegistrableMachine(agent.Machine) {
+			continue
+		}
+		path := filepath.Join(agent.Directory, "CLAUDE.md.backup")
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if olderThan > 0 && info.ModTime().After(cutoff) {
+			continue
+		}
+
+		if dryRun {
+			fmt.Printf("would remove %s (modified %s)\n", path, info.ModTime().Format("2006-01-02"))
+			removed++
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not remove %s: %v\n", path, err)
+			continue
+		}
+		fmt.Printf("removed %s\n", path)
+		removed++
+	}
+
+	if removed == 0 {
+		fmt.Println("No backup files found")
+	} else if dryRun {
+		fmt.Printf("%d backup file(s) would be removed\n", removed)
+	} else {
+		fmt.Printf("%d backup file(s) removed\n", removed)
+		logEvent("backup_cleanup", fmt.Sprintf("%d file(s) removed", removed))
+	}
+}
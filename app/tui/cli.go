@@ -0,0 +1,192 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// Subcommand dispatch for the unified `slay` CLI. The messenger stays its
+// own binary (separate module), so `slay msg` delegates to it - users only
+// need `slay` on PATH.
+
+// runSlaySubcommand handles any recognized subcommand and reports whether
+// it consumed the invocation; otherwise the full TUI starts
+func runSlaySubcommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+
+	switch args[0] {
+	case "--version", "version":
+		fmt.Println(versionString())
+	case "workflow":
+		runWorkflowCommand(args[1:])
+	case "events":
+		runEventsCommand()
+	case "run":
+		runScriptCommand(args[1:])
+	case "plugin":
+		runPluginCommand(args[1:])
+	case "task":
+		runTaskCommand(args[1:])
+	case "msg":
+		runMsgCommand(args[1:])
+	case "sync":
+		runSyncCommand(args[1:])
+	case "registry":
+		runRegistryCommand(args[1:])
+	case "snippet":
+		runSnippetCommand(args[1:])
+	case "search":
+		runSearchCommand(args[1:])
+	case "history":
+		runHistoryCommand(args[1:])
+	case "db":
+		runDBCommand(args[1:])
+	case "doctor":
+		runDoctorCommand(args[1:])
+	case "mcp":
+		runMCPCommand(args[1:])
+	case "serve":
+		runServeCommand(args[1:])
+	default:
+		return false
+	}
+	return true
+}
+
+// runMsgCommand handles `slay msg ...`, delegating to the msg binary
+func runMsgCommand(args []string) {
+	msgPath, err := exec.LookPath("msg")
+	if err != nil {
+		// Fall back to the standard install location
+		home, homeErr := os.UserHomeDir()
+		if homeErr != nil {
+			fmt.Fprintln(os.Stderr, "Error: msg binary not found - run install.sh")
+			os.Exit(1)
+		}
+		msgPath = filepath.Join(home, ".local", "bin", "msg")
+		if _, statErr := os.Stat(msgPath); statErr != nil {
+			fmt.Fprintln(os.Stderr, "Error: msg binary not found - run install.sh")
+			os.Exit(1)
+		}
+	}
+
+	cmd := exec.Command(msgPath, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			os.Exit(exitErr.ExitCode())
+		}
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// runSyncCommand handles `slay sync`, running the standard CLAUDE.md sync
+// without launching the TUI; `slay sync clean-backups` removes the .backup
+// files sync leaves behind
+func runSyncCommand(args []string) {
+	if len(args) > 0 && args[0] == "clean-backups" {
+		runCleanBackups(args[1:])
+		return
+	}
+
+	// --everywhere also syncs every machine in the SSH registry
+	if len(args) > 0 && args[0] == "--everywhere" {
+		sshRegistry, err := NewSSHRegistry()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println("Syncing registry to CLAUDE.md files everywhere...")
+		for _, line := range syncEverywhere(sshRegistry) {
+			fmt.Println(line)
+		}
+		return
+	}
+
+	fmt.Println("Syncing registry to CLAUDE.md files...")
+	if err := executeSync(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// runRegistryCommand handles `slay registry`: no arguments lists registered
+// agents, `add <name> <type> [directory]` registers one from the shell (the
+// startup snippets use this to self-register)
+func runRegistryCommand(args []string) {
+	registry, err := NewRegistry()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(args) > 0 && args[0] == "add" {
+		if len(args) < 3 {
+			fmt.Fprintln(os.Stderr, "Usage: slay registry add <name> <type> [directory]")
+			os.Exit(1)
+		}
+		directory := ""
+		if len(args) >= 4 {
+			directory = args[3]
+		} else if directory, err = os.Getwd(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if err := registry.RegisterWithMachine(args[1], args[2], directory, "host"); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Registered %s (%s) in %s\n", args[1], args[2], directory)
+		return
+	}
+
+	agents := registry.GetAgents()
+	if len(agents) == 0 {
+		fmt.Println("No agents registered")
+		return
+	}
+
+	for _, agent := range agents {
+		line := fmt.Sprintf("%-16s %-10s %-8s %s", agent.Name, agent.AgentType, agent.Machine, agent.Directory)
+		if agent.Pane != "" {
+			line += " (pane " + agent.Pane + ")"
+		}
+		fmt.Println(line)
+	}
+}
+
+// runDBCommand handles `slay db`, opening a sqlite3 shell on the message
+// database (extra args pass straight through, e.g. `slay db '.schema'`)
+func runDBCommand(args []string) {
+	sqlitePath, err := exec.LookPath("sqlite3")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error: sqlite3 not found on PATH")
+		os.Exit(1)
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	dbPath := filepath.Join(home, ".slaygent", "messages.db")
+
+	cmd := exec.Command(sqlitePath, append([]string{dbPath}, args...)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			os.Exit(exitErr.ExitCode())
+		}
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
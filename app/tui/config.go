@@ -0,0 +1,197 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"slaygent-manager/views"
+
+	"gopkg.in/yaml.v3"
+)
+
+// User configuration from ~/.slaygent/config.yaml, the file shared by slay,
+// msg and msg-ssh - each binary parses just the keys it needs. The TUI reads
+// "columns": which agents-table columns appear and in what order. Valid
+// names: pane, directory, agent, name, status, machine, registered, plus the
+// computed columns last_message and tags. An absent or empty list keeps the
+// default layout. The pre-YAML config.json is still read as a fallback.
+
+type tuiConfig struct {
+	Columns       []string     `yaml:"columns,omitempty" json:"columns,omitempty"`
+	QuickReplies  []quickReply `yaml:"quick_replies,omitempty" json:"quick_replies,omitempty"`
+	ExportPath    string       `yaml:"export_path,omitempty" json:"export_path,omitempty"`       // transcript export directory
+	ExportWebhook string       `yaml:"export_webhook,omitempty" json:"export_webhook,omitempty"` // POST transcripts here too
+	NameTemplate  string       `yaml:"name_template,omitempty" json:"name_template,omitempty"`   // e.g. "{dirbase}-{type}"
+
+	// Include .gitignore'd and junk-directory files in sync file discovery
+	DiscoverIgnored bool `yaml:"discover_ignored,omitempty" json:"discover_ignored,omitempty"`
+
+	// Rewrite historical messages to the new name when an agent is renamed
+	RenameHistory bool `yaml:"rename_history,omitempty" json:"rename_history,omitempty"`
+
+	// UI language: loads the catalogue at ~/.slaygent/locale/<locale>.json
+	Locale string `yaml:"locale,omitempty" json:"locale,omitempty"`
+
+	// Keybinding overrides: action name -> key (see defaultKeyBindings)
+	Keymap map[string]string `yaml:"keymap,omitempty" json:"keymap,omitempty"`
+
+	// Auto-registration (zero-touch roster)
+	AutoRegister               bool     `yaml:"auto_register,omitempty" json:"auto_register,omitempty"`
+	AutoRegisterDirs           []string `yaml:"auto_register_dirs,omitempty" json:"auto_register_dirs,omitempty"`
+	AutoDeregisterGraceSeconds int      `yaml:"auto_deregister_grace_seconds,omitempty" json:"auto_deregister_grace_seconds,omitempty"`
+}
+
+// autoGrace returns auto_deregister_grace_seconds as a duration, defaulting
+// to two minutes
+func (c tuiConfig) autoGrace() time.Duration {
+	if c.AutoDeregisterGraceSeconds > 0 {
+		return time.Duration(c.AutoDeregisterGraceSeconds) * time.Second
+	}
+	return 2 * time.Minute
+}
+
+// quickReply is one canned reply; number keys 1-9 in the messages view send
+// them in listed order (same entries `msg --quick <name>` uses)
+type quickReply struct {
+	Name string `yaml:"name" json:"name"`
+	Text string `yaml:"text" json:"text"`
+}
+
+var defaultQuickReplies = []quickReply{
+	{Name: "ack", Text: "Acknowledged."},
+	{Name: "onit", Text: "On it."},
+	{Name: "blocked", Text: "Blocked, need input."},
+}
+
+// quickReplies returns the configured entries followed by any defaults the
+// user has not overridden
+func (c tuiConfig) quickReplies() []quickReply {
+	replies := c.QuickReplies
+	for _, def := range defaultQuickReplies {
+		overridden := false
+		for _, r := range replies {
+			if r.Name == def.Name {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			replies = append(replies, def)
+		}
+	}
+	return replies
+}
+
+// loadTUIConfig reads the config file, returning zero-value defaults when absent
+func loadTUIConfig() tuiConfig {
+	var config tuiConfig
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return config
+	}
+
+	if data, err := os.ReadFile(filepath.Join(home, ".slaygent", "config.yaml")); err == nil {
+		if err := yaml.Unmarshal(data, &config); err != nil {
+			debugLog("WARN", "config", "config.yaml is not valid YAML, using defaults")
+			return tuiConfig{}
+		}
+		return config
+	}
+
+	// Legacy location from before the shared YAML config
+	data, err := os.ReadFile(filepath.Join(home, ".slaygent", "config.json"))
+	if err != nil {
+		return config // No config file, use defaults
+	}
+
+	if err := json.Unmarshal(data, &config); err != nil {
+		debugLog("WARN", "config", "config.json is not valid JSON, using defaults")
+		return tuiConfig{}
+	}
+	return config
+}
+
+// hasColumn reports whether the configured column list includes name
+func (c tuiConfig) hasColumn(name string) bool {
+	for _, col := range c.Columns {
+		if col == name {
+			return true
+		}
+	}
+	return false
+}
+
+// tableOptions builds the column configuration for BuildBubbleTable,
+// computing the optional LAST MESSAGE and TAGS data only when configured
+func (m model) tableOptions() views.TableOptions {
+	opts := views.TableOptions{Columns: m.config.Columns}
+	if m.config.hasColumn("last_message") {
+		opts.LastMessages = loadLastMessageLabels()
+	}
+	if m.config.hasColumn("tags") {
+		opts.Tags = m.tagLabels()
+	}
+	if m.registry != nil {
+		opts.Colors = m.registry.ColorOverrides()
+		opts.DND = m.registry.DNDAgents()
+	}
+	return opts
+}
+
+// loadLastMessageLabels returns registered name -> "how long ago" label of
+// the most recent message the agent sent or received
+func loadLastMessageLabels() map[string]string {
+	db, err := openEventsDB()
+	if err != nil {
+		debugLogError("db", err)
+		return nil
+	}
+	defer db.Close()
+
+	latest := make(map[string]time.Time)
+	for _, column := range []string{"sender_name", "receiver_name"} {
+		rows, err := db.Query("SELECT " + column + ", MAX(datetime(sent_at, 'localtime')) FROM messages GROUP BY " + column)
+		if err != nil {
+			return nil // Messages table may not exist yet
+		}
+		for rows.Next() {
+			var name, stamp string
+			if rows.Scan(&name, &stamp) != nil {
+				continue
+			}
+			t, err := time.ParseInLocation("2006-01-02 15:04:05", stamp, time.Local)
+			if err != nil {
+				continue
+			}
+			if t.After(latest[name]) {
+				latest[name] = t
+			}
+		}
+		rows.Close()
+	}
+
+	labels := make(map[string]string)
+	for name, t := range latest {
+		labels[name] = lastSeenLabel(t)
+	}
+	return labels
+}
+
+// tagLabels returns registered name -> comma-joined tags from the registry
+func (m model) tagLabels() map[string]string {
+	if m.registry == nil {
+		return nil
+	}
+
+	labels := make(map[string]string)
+	for _, agent := range m.registry.GetAgents() {
+		if len(agent.Tags) > 0 {
+			labels[agent.Name] = strings.Join(agent.Tags, ", ")
+		}
+	}
+	return labels
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// getDockerPanes discovers AI agents running inside local docker containers.
+// Each running container is probed for a tmux server; panes running a known
+// agent are returned as display rows with machine set to "docker:<container>"
+// so they appear alongside host agents.
+func getDockerPanes(registry *Registry) [][]string {
+	containers := listDockerContainers()
+	if len(containers) == 0 {
+		return nil
+	}
+
+	var rows [][]string
+	for _, container := range containers {
+		for _, row := range discoverContainerAgents(container) {
+			machine := "docker:" + container
+
+			// Check registration status against the local registry
+			registered := "✗"
+			name := row[3]
+			if registry != nil && registry.IsRegisteredWithMachine(row[2], row[1], machine) {
+				registered = "✓"
+				if regName := registry.GetNameWithMachine(row[2], row[1], machine); regName != "" {
+					name = regName
+				}
+			}
+
+			rows = append(rows, []string{
+				row[0],     // Pane ID (inside the container)
+				row[1],     // Directory
+				row[2],     // Agent type
+				name,       // Display name
+				row[4],     // Status
+				machine,    // Machine column identifies the container
+				registered, // Registration status
+			})
+		}
+	}
+
+	return rows
+}
+
+// listDockerContainers returns the names of running containers, or nil when
+// docker isn't installed or the daemon isn't reachable.
+func listDockerContainers() []string {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "docker", "ps", "--format", "{{.Names}}")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+
+	var containers []string
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			containers = append(containers, line)
+		}
+	}
+	return containers
+}
+
+// discoverContainerAgents lists tmux panes inside a container and keeps only
+// those running a known AI agent. Containers without tmux are silently skipped.
+func discoverContainerAgents(container string) [][]string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	format := "#{session_name}:#{window_index}.#{pane_index}:#{pane_current_path}:#{pane_current_command}:#{?pane_active,active,idle}"
+	cmd := exec.CommandContext(ctx, "docker", "exec", container, "tmux", "list-panes", "-a", "-F", format)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil // No tmux server in this container
+	}
+
+	var rows [][]string
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		parts := strings.Split(line, ":")
+		if len(parts) < 5 {
+			continue
+		}
+
+		paneID := parts[0] + ":" + parts[1]
+		directory := parts[2]
+		command := parts[3]
+		status := parts[4]
+
+		agentType := detectAgentType(command)
+		if agentType == "unknown" {
+			continue
+		}
+
+		rows = append(rows, []string{paneID, directory, agentType, paneID, status})
+	}
+
+	return rows
+}
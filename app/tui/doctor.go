@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"slaygent-manager/views"
+)
+
+// Diagnostics: one place that verifies every external dependency the TUI
+// leans on (tmux, fd, the msg binaries, SSH keys, the message database) and
+// suggests a fix command for anything missing.
+
+// checkBinary reports whether a command is on PATH, with an install hint
+func checkBinary(name, installHint string) views.DiagCheck {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return views.DiagCheck{
+			Name:   name,
+			OK:     false,
+			Detail: "not found on PATH",
+			Fix:    installHint,
+		}
+	}
+	return views.DiagCheck{Name: name, OK: true, Detail: path}
+}
+
+// checkSyncTargets verifies the directories the native sync engine writes
+// CLAUDE.md files into still exist
+func checkSyncTargets() views.DiagCheck {
+	registry, err := NewRegistry()
+	if err != nil {
+		return views.DiagCheck{Name: "sync targets", OK: false, Detail: "registry unreadable"}
+	}
+
+	dirs := localSyncDirectories(registry)
+	missing := 0
+	for _, dir := range dirs {
+		if _, err := os.Stat(dir); err != nil {
+			missing++
+		}
+	}
+	if missing > 0 {
+		return views.DiagCheck{
+			Name:   "sync targets",
+			OK:     false,
+			Detail: fmt.Sprintf("%d registered directories no longer exist", missing),
+			Fix:    "deregister stale agents in the agents view",
+		}
+	}
+	return views.DiagCheck{Name: "sync targets", OK: true, Detail: fmt.Sprintf("%d director(ies) registered", len(dirs))}
+}
+
+// checkSSHKeys looks for usable private keys under ~/.ssh
+func checkSSHKeys() views.DiagCheck {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return views.DiagCheck{Name: "ssh keys", OK: false, Detail: err.Error()}
+	}
+
+	keys := getSSHKeys()
+	if len(keys) == 0 {
+		return views.DiagCheck{
+			Name:   "ssh keys",
+			OK:     false,
+			Detail: fmt.Sprintf("no private keys in %s", filepath.Join(home, ".ssh")),
+			Fix:    "ssh-keygen -t ed25519",
+		}
+	}
+	return views.DiagCheck{Name: "ssh keys", OK: true, Detail: fmt.Sprintf("%d key(s) found", len(keys))}
+}
+
+// checkRegistry verifies the agent registry file exists and parses
+func checkRegistry() views.DiagCheck {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return views.DiagCheck{Name: "registry", OK: false, Detail: err.Error()}
+	}
+
+	path := filepath.Join(home, ".slaygent", "registry.json")
+	if _, err := os.Stat(path); err != nil {
+		return views.DiagCheck{
+			Name:   "registry",
+			OK:     false,
+			Detail: "registry.json not found (created on first registration)",
+			Fix:    "press 'a' in the agents view to register an agent",
+		}
+	}
+
+	registry, err := NewRegistry()
+	if err != nil {
+		return views.DiagCheck{
+			Name:   "registry",
+			OK:     false,
+			Detail: "registry.json is not valid JSON",
+			Fix:    "mv ~/.slaygent/registry.json ~/.slaygent/registry.json.bak",
+		}
+	}
+	return views.DiagCheck{Name: "registry", OK: true, Detail: fmt.Sprintf("%d agent(s) registered", len(registry.GetAgents()))}
+}
+
+// checkDatabase opens the message DB and runs a trivial query
+func checkDatabase() views.DiagCheck {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return views.DiagCheck{Name: "message db", OK: false, Detail: err.Error()}
+	}
+
+	path := filepath.Join(home, ".slaygent", "messages.db")
+	if _, err := os.Stat(path); err != nil {
+		return views.DiagCheck{
+			Name:   "message db",
+			OK:     false,
+			Detail: "messages.db not found (created on first message)",
+			Fix:    "msg <agent> \"hello\"",
+		}
+	}
+
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return views.DiagCheck{Name: "message db", OK: false, Detail: err.Error()}
+	}
+	defer db.Close()
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM messages").Scan(&count); err != nil {
+		return views.DiagCheck{
+			Name:   "message db",
+			OK:     false,
+			Detail: fmt.Sprintf("query failed: %v", err),
+			Fix:    "sqlite3 ~/.slaygent/messages.db '.schema messages'",
+		}
+	}
+	return views.DiagCheck{Name: "message db", OK: true, Detail: fmt.Sprintf("%d message(s) stored", count)}
+}
+
+// checkTmuxServer verifies a tmux server is actually reachable, beyond the
+// binary merely being installed
+func checkTmuxServer() views.DiagCheck {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if !isTmuxRunning(ctx) {
+		return views.DiagCheck{
+			Name:   "tmux server",
+			OK:     false,
+			Detail: "no tmux server running",
+			Fix:    "tmux new",
+		}
+	}
+	return views.DiagCheck{Name: "tmux server", OK: true, Detail: "server reachable"}
+}
+
+// runDiagnostics runs every dependency check in display order
+func runDiagnostics() []views.DiagCheck {
+	return []views.DiagCheck{
+		checkBinary("tmux", "brew install tmux"),
+		checkTmuxServer(),
+		checkBinary("fd", "brew install fd"),
+		checkBinary("msg", "brew install slaygent-comms (or run install.sh)"),
+		checkBinary("msg-ssh", "brew install slaygent-comms (or run install.sh)"),
+		checkSyncTargets(),
+		checkSSHKeys(),
+		checkRegistry(),
+		checkDatabase(),
+	}
+}
+
+// runDoctorCommand handles `slay doctor`: the same dependency checks the
+// TUI diagnostics view runs, printed for support requests. --json emits
+// machine-readable output; the exit code is the number of failing checks.
+func runDoctorCommand(args []string) {
+	jsonOut := false
+	for _, arg := range args {
+		if arg == "--json" {
+			jsonOut = true
+		}
+	}
+
+	checks := runDiagnostics()
+	failed := 0
+	for _, check := range checks {
+		if !check.OK {
+			failed++
+		}
+	}
+
+	if jsonOut {
+		out := struct {
+			OK     bool              `json:"ok"`
+			Failed int               `json:"failed"`
+			Checks []views.DiagCheck `json:"checks"`
+		}{OK: failed == 0, Failed: failed, Checks: checks}
+		data, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+	} else {
+		for _, check := range checks {
+			mark := "✓"
+			if !check.OK {
+				mark = "✗"
+			}
+			line := fmt.Sprintf("%s %-12s %s", mark, check.Name, check.Detail)
+			if !check.OK && check.Fix != "" {
+				line += " (fix: " + check.Fix + ")"
+			}
+			fmt.Println(line)
+		}
+		fmt.Printf("%d of %d checks passed\n", len(checks)-failed, len(checks))
+	}
+
+	os.Exit(failed)
+}
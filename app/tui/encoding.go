@@ -0,0 +1,39 @@
+package main
+
+import "strings"
+
+// Encoding conventions sync must preserve when rewriting CLAUDE.md and
+// AGENTS.md files in cross-platform repos: a UTF-8 byte order mark at the
+// top of the file, and CRLF line endings in Windows-maintained files.
+// Normalizing either would churn every line in the owner's next diff.
+
+const utf8BOM = "\ufeff"
+
+// splitBOM separates a leading UTF-8 byte order mark from the content so
+// marker searches and rewrites work on the text, then the BOM can be
+// restored on write
+func splitBOM(content string) (bom, rest string) {
+	if strings.HasPrefix(content, utf8BOM) {
+		return utf8BOM, strings.TrimPrefix(content, utf8BOM)
+	}
+	return "", content
+}
+
+// fileLineEnding reports the line ending convention of existing content:
+// "\r\n" when the file uses CRLF anywhere, "\n" otherwise
+func fileLineEnding(content string) string {
+	if strings.Contains(content, "\r\n") {
+		return "\r\n"
+	}
+	return "\n"
+}
+
+// adaptLineEndings rewrites content to the given line ending, normalizing
+// to LF first so already-CRLF input doesn't double its carriage returns
+func adaptLineEndings(content, eol string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	if eol == "\n" {
+		return content
+	}
+	return strings.ReplaceAll(content, "\n", eol)
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Audit log: significant actions (registration, deregistration, sync runs,
+// SSH connection changes, message sends and deletions) are appended to an
+// events table in messages.db, viewable in the TUI Activity view or with
+// `slay events`, for debugging multi-agent mishaps.
+
+const eventsSchema = `
+CREATE TABLE IF NOT EXISTS events (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	event_type TEXT NOT NULL,
+	detail TEXT NOT NULL,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);`
+
+// Event is one recorded action from the append-only audit log
+type Event struct {
+	Type      string
+	Detail    string
+	CreatedAt string
+}
+
+// openEventsDB opens messages.db and ensures the events table exists
+func openEventsDB() (*sql.DB, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	slaygentDir := filepath.Join(home, ".slaygent")
+	os.MkdirAll(slaygentDir, 0755)
+
+	db, err := sql.Open("sqlite3", filepath.Join(slaygentDir, "messages.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Exec(eventsSchema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// logEvent appends an action to the audit log. Best effort - audit failures
+// never interrupt the action being recorded.
+func logEvent(eventType, detail string) {
+	db, err := openEventsDB()
+	if err != nil {
+		return
+	}
+	defer db.Close()
+
+	db.Exec(`INSERT INTO events (event_type, detail) VALUES (?, ?)`, eventType, detail)
+}
+
+// loadEvents returns the most recent audit entries, newest first
+func loadEvents(limit int) ([]Event, error) {
+	db, err := openEventsDB()
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	rows, err := db.Query(`
+		SELECT event_type, detail, datetime(created_at, 'localtime')
+		FROM events
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []Event
+	for rows.Next() {
+		var e Event
+		if err := rows.Scan(&e.Type, &e.Detail, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+// PendingReply is an outstanding reply expectation recorded by
+// `msg --expect-reply`
+type PendingReply struct {
+	Expecter string
+	Replier  string
+	Deadline string
+	Overdue  bool
+}
+
+// loadPendingReplies returns unfulfilled reply expectations, soonest first.
+// Returns nothing when the expectations table doesn't exist yet.
+func loadPendingReplies() []PendingReply {
+	db, err := openEventsDB()
+	if err != nil {
+		return nil
+	}
+	defer db.Close()
+
+	rows, err := db.Query(`
+		SELECT expecter, replier, datetime(deadline, 'localtime'),
+		       deadline < CURRENT_TIMESTAMP
+		FROM expected_replies
+		WHERE fulfilled_at IS NULL
+		ORDER BY deadline ASC`)
+	if err != nil {
+		return nil
+	}
+	defer rows.Close()
+
+	var pending []PendingReply
+	for rows.Next() {
+		var p PendingReply
+		if err := rows.Scan(&p.Expecter, &p.Replier, &p.Deadline, &p.Overdue); err != nil {
+			return pending
+		}
+		pending = append(pending, p)
+	}
+	return pending
+}
+
+// runEventsCommand handles `slay events`, printing recent audit entries
+func runEventsCommand() {
+	events, err := loadEvents(50)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(events) == 0 {
+		fmt.Println("No events recorded")
+		return
+	}
+
+	for _, e := range events {
+		fmt.Printf("[%s] %-18s %s\n", e.CreatedAt, e.Type, e.Detail)
+	}
+}
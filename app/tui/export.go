@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"slaygent-manager/history"
+)
+
+// Transcript export for conversations marked done in the messages view.
+// The transcript always lands under ~/.slaygent/exports (or export_path
+// from the config); export_webhook additionally POSTs it as JSON, handy
+// for attaching agent collaboration logs to PRs.
+
+// exportTranscript writes one conversation transcript out, returning the
+// file path it landed in
+func exportTranscript(conv *history.Conversation, transcript string, config tuiConfig) (string, error) {
+	dir := config.ExportPath
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".slaygent", "exports")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	name := fmt.Sprintf("%s-%s-%s.md", conv.Agent1Name, conv.Agent2Name, time.Now().Format("20060102-150405"))
+	path := filepath.Join(dir, name)
+	content := fmt.Sprintf("# Conversation: %s ↔ %s\n\n```\n%s\n```\n",
+		conv.Agent1Name, conv.Agent2Name, transcript)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return "", err
+	}
+
+	if config.ExportWebhook != "" {
+		payload, err := json.Marshal(map[string]string{
+			"agent1":     conv.Agent1Name,
+			"agent2":     conv.Agent2Name,
+			"transcript": transcript,
+		})
+		if err != nil {
+			return path, err
+		}
+		client := &http.Client{Timeout: 5 * time.Second}
+		resp, err := client.Post(config.ExportWebhook, "application/json", bytes.NewReader(payload))
+		if err != nil {
+			return path, fmt.Errorf("webhook: %w", err)
+		}
+		resp.Body.Close()
+	}
+
+	return path, nil
+}
@@ -2,10 +2,13 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
 	"time"
 )
@@ -15,7 +18,9 @@ type DiscoveredFile struct {
 	Path      string // Full path to the file
 	Type      string // "CLAUDE.md" or "AGENTS.md"
 	Directory string // Parent directory name for display
+	Project   string // Project root (git toplevel, or the file's directory)
 	Selected  bool   // Whether user has selected this file
+	Missing   bool   // File doesn't exist yet; sync will create it
 }
 
 // fileDiscoveryMsg contains the result of file discovery
@@ -28,7 +33,7 @@ type fileDiscoveryMsg struct {
 type fileDiscoveryTickMsg struct{}
 
 // discoverFiles finds all CLAUDE.md and AGENTS.md files using fd command
-func discoverFiles() ([]DiscoveredFile, error) {
+func discoverFiles(includeIgnored bool) ([]DiscoveredFile, error) {
 	// Create context with timeout to prevent hanging
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -38,7 +43,20 @@ func discoverFiles() ([]DiscoveredFile, error) {
 	if err != nil {
 		return nil, err
 	}
-	cmd := exec.CommandContext(ctx, "fd", "-t", "f", "-H", "^(CLAUDE|AGENTS)\\.md$", homeDir)
+
+	// fd respects .gitignore by default; also skip common junk directories
+	// full of vendored copies. includeIgnored ('I' in the picker, or
+	// discover_ignored: true in the config) lifts both filters.
+	args := []string{"-t", "f", "-H"}
+	if includeIgnored {
+		args = append(args, "-I")
+	} else {
+		for _, junk := range []string{"node_modules", ".venv", "venv", "vendor", "Library", ".cache", ".git"} {
+			args = append(args, "--exclude", junk)
+		}
+	}
+	args = append(args, "^(CLAUDE|AGENTS)\\.md$", homeDir)
+	cmd := exec.CommandContext(ctx, "fd", args...)
 
 	output, err := cmd.Output()
 	if err != nil {
@@ -102,13 +120,161 @@ func discoverFiles() ([]DiscoveredFile, error) {
 		}
 	}
 
+	// Group by project root so the picker can offer per-project selection
+	rootCache := make(map[string]string)
+	for i := range files {
+		files[i].Project = projectRoot(filepath.Dir(files[i].Path), rootCache)
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		if files[i].Project != files[j].Project {
+			return files[i].Project < files[j].Project
+		}
+		return files[i].Path < files[j].Path
+	})
+
 	return files, nil
 }
 
+// addMissingProjectFiles appends a creatable CLAUDE.md entry for each
+// locally registered agent whose project has neither CLAUDE.md nor
+// AGENTS.md, so sync can bootstrap new projects instead of only updating
+// files that already exist
+func addMissingProjectFiles(files []DiscoveredFile, registry *Registry) []DiscoveredFile {
+	if registry == nil {
+		return files
+	}
+
+	covered := make(map[string]bool)
+	for _, file := range files {
+		covered[filepath.Dir(file.Path)] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, agent := range registry.GetAgents() {
+		if !isRegistrableMachine(agent.Machine) || agent.Directory == "" {
+			continue
+		}
+		dir := agent.Directory
+		if covered[dir] || seen[dir] {
+			continue
+		}
+		seen[dir] = true
+
+		// Discovery excludes may have hidden an existing file - never
+		// offer to create over one
+		if _, err := os.Stat(filepath.Join(dir, "CLAUDE.md")); err == nil {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, "AGENTS.md")); err == nil {
+			continue
+		}
+
+		rootCache := make(map[string]string)
+		files = append(files, DiscoveredFile{
+			Path:      filepath.Join(dir, "CLAUDE.md"),
+			Type:      "CLAUDE.md",
+			Directory: filepath.Base(dir),
+			Project:   projectRoot(dir, rootCache),
+			Missing:   true,
+		})
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		if files[i].Project != files[j].Project {
+			return files[i].Project < files[j].Project
+		}
+		return files[i].Path < files[j].Path
+	})
+	return files
+}
+
+// projectRoot walks up from dir looking for a .git entry (the project
+// toplevel), falling back to dir itself for files outside any repo
+func projectRoot(dir string, cache map[string]string) string {
+	if root, ok := cache[dir]; ok {
+		return root
+	}
+
+	root := dir
+	for probe := dir; ; {
+		if _, err := os.Stat(filepath.Join(probe, ".git")); err == nil {
+			root = probe
+			break
+		}
+		parent := filepath.Dir(probe)
+		if parent == probe {
+			break // Reached filesystem root without finding a repo
+		}
+		probe = parent
+	}
+
+	cache[dir] = root
+	return root
+}
+
+// pickerRow is one navigable line in the grouped file picker: a project
+// header or a file index beneath it
+type pickerRow struct {
+	header    bool
+	project   string
+	fileIndex int
+}
+
+// buildPickerRows lays out headers and file rows, hiding files whose
+// project group is collapsed
+func buildPickerRows(files []DiscoveredFile, collapsed map[string]bool) []pickerRow {
+	var rows []pickerRow
+	lastProject := ""
+	for i, file := range files {
+		if file.Project != lastProject {
+			rows = append(rows, pickerRow{header: true, project: file.Project})
+			lastProject = file.Project
+		}
+		if !collapsed[file.Project] {
+			rows = append(rows, pickerRow{project: file.Project, fileIndex: i})
+		}
+	}
+	return rows
+}
+
+// groupCounts returns selected and total file counts for one project group
+func groupCounts(files []DiscoveredFile, project string) (int, int) {
+	selected, total := 0, 0
+	for _, file := range files {
+		if file.Project != project {
+			continue
+		}
+		total++
+		if file.Selected {
+			selected++
+		}
+	}
+	return selected, total
+}
+
+// toggleGroupSelection selects every file in the project group, or
+// deselects them all when the group is already fully selected
+func toggleGroupSelection(files []DiscoveredFile, project string) []DiscoveredFile {
+	selected, total := groupCounts(files, project)
+	selectAll := selected < total
+	for i := range files {
+		if files[i].Project == project {
+			files[i].Selected = selectAll
+		}
+	}
+	return files
+}
+
 // fdNotFoundError represents when fd command is not available
 type fdNotFoundError struct{}
 
 func (e *fdNotFoundError) Error() string {
+	if runtime.GOOS == "windows" {
+		return "fd command not found - install with: winget install sharkdp.fd"
+	}
+	if isWSL() {
+		return "fd command not found - install with: apt install fd-find (binary is 'fdfind')"
+	}
 	return "fd command not found - install with: brew install fd"
 }
 
@@ -132,6 +298,65 @@ func (e *noValidFilesError) Error() string {
 	return fmt.Sprintf("found %d files in %s but none were valid CLAUDE.md/AGENTS.md files", e.foundCount, e.searchPath)
 }
 
+// syncSelectionsPath returns where the last custom sync's file selection is
+// remembered, creating the directory
+func syncSelectionsPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	slaygentDir := filepath.Join(home, ".slaygent")
+	os.MkdirAll(slaygentDir, 0755)
+	return filepath.Join(slaygentDir, "sync-selections.json")
+}
+
+// saveSyncSelections remembers the selected paths so the next picker run
+// can pre-select the same project set. Best effort.
+func saveSyncSelections(selected []DiscoveredFile) {
+	path := syncSelectionsPath()
+	if path == "" {
+		return
+	}
+
+	var paths []string
+	for _, file := range selected {
+		paths = append(paths, file.Path)
+	}
+	data, err := json.MarshalIndent(paths, "", "  ")
+	if err != nil {
+		return
+	}
+	os.WriteFile(path, data, 0644)
+}
+
+// applySavedSelections pre-selects the files chosen in the last custom sync
+func applySavedSelections(files []DiscoveredFile) []DiscoveredFile {
+	path := syncSelectionsPath()
+	if path == "" {
+		return files
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return files // No previous sync
+	}
+	var paths []string
+	if err := json.Unmarshal(data, &paths); err != nil {
+		return files
+	}
+
+	saved := make(map[string]bool, len(paths))
+	for _, p := range paths {
+		saved[p] = true
+	}
+	for i := range files {
+		if saved[files[i].Path] {
+			files[i].Selected = true
+		}
+	}
+	return files
+}
+
 // selectCurrentProjectFiles automatically selects files in/under current working directory
 func selectCurrentProjectFiles(files []DiscoveredFile) []DiscoveredFile {
 	cwd, err := os.Getwd()
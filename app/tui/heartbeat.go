@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Heartbeats are written by `msg --heartbeat` to ~/.slaygent/heartbeats.json
+// (agent name -> RFC3339 timestamp). The TUI reads them to show last-seen
+// info so a registered-but-dead agent is distinguishable from an active one.
+
+// loadHeartbeats reads recorded heartbeat timestamps, keyed by agent name
+func loadHeartbeats() map[string]time.Time {
+	heartbeats := make(map[string]time.Time)
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return heartbeats
+	}
+
+	data, err := os.ReadFile(filepath.Join(home, ".slaygent", "heartbeats.json"))
+	if err != nil {
+		return heartbeats // No heartbeats recorded yet
+	}
+
+	var raw map[string]string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return heartbeats
+	}
+
+	for name, stamp := range raw {
+		if t, err := time.Parse(time.RFC3339, stamp); err == nil {
+			heartbeats[name] = t
+		}
+	}
+	return heartbeats
+}
+
+// lastSeenLabel formats a heartbeat timestamp as a short relative age
+func lastSeenLabel(t time.Time) string {
+	age := time.Since(t)
+	switch {
+	case age < time.Minute:
+		return "just now"
+	case age < time.Hour:
+		return fmt.Sprintf("%dm ago", int(age.Minutes()))
+	case age < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(age.Hours()/24))
+	}
+}
+
+// heartbeatLabels returns display-ready last-seen strings for the agents view
+func heartbeatLabels() map[string]string {
+	labels := make(map[string]string)
+	for name, t := range loadHeartbeats() {
+		labels[name] = lastSeenLabel(t)
+	}
+	return labels
+}
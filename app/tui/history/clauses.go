@@ -0,0 +1,70 @@
+package history
+
+// Named sync clause storage backing the multi-clause sync editor. Each row
+// is one clause ("default", "minimal", "verbose", ...); at most one is
+// active, and custom sync pushes the active clause's content.
+
+// SyncClauses returns all stored clauses ordered by name.
+func (m *Model) SyncClauses() ([]SyncClause, error) {
+	rows, err := m.db.Query(`
+		SELECT id, clause_type, content, is_active, created_at, updated_at
+		FROM sync_clauses
+		ORDER BY clause_type ASC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var clauses []SyncClause
+	for rows.Next() {
+		var clause SyncClause
+		if err := rows.Scan(&clause.ID, &clause.ClauseType, &clause.Content,
+			&clause.IsActive, &clause.CreatedAt, &clause.UpdatedAt); err != nil {
+			continue
+		}
+		clauses = append(clauses, clause)
+	}
+	return clauses, nil
+}
+
+// SaveSyncClause stores content under the given clause name, replacing any
+// existing clause with that name.
+func (m *Model) SaveSyncClause(clauseType, content string) error {
+	_, err := m.db.Exec(`
+		INSERT INTO sync_clauses (clause_type, content)
+		VALUES (?, ?)
+		ON CONFLICT(clause_type) DO UPDATE SET
+			content = excluded.content,
+			updated_at = CURRENT_TIMESTAMP`, clauseType, content)
+	return err
+}
+
+// SetActiveSyncClause marks one clause active and the rest inactive.
+func (m *Model) SetActiveSyncClause(clauseType string) error {
+	if _, err := m.db.Exec(`UPDATE sync_clauses SET is_active = 0`); err != nil {
+		return err
+	}
+	_, err := m.db.Exec(`UPDATE sync_clauses SET is_active = 1 WHERE clause_type = ?`, clauseType)
+	return err
+}
+
+// ActiveSyncClause returns the active clause, or nil when none is stored.
+func (m *Model) ActiveSyncClause() (*SyncClause, error) {
+	var clause SyncClause
+	err := m.db.QueryRow(`
+		SELECT id, clause_type, content, is_active, created_at, updated_at
+		FROM sync_clauses
+		WHERE is_active = 1
+		LIMIT 1`).Scan(&clause.ID, &clause.ClauseType, &clause.Content,
+		&clause.IsActive, &clause.CreatedAt, &clause.UpdatedAt)
+	if err != nil {
+		return nil, nil // No active clause (or table empty)
+	}
+	return &clause, nil
+}
+
+// DeleteSyncClause removes a stored clause by name.
+func (m *Model) DeleteSyncClause(clauseType string) error {
+	_, err := m.db.Exec(`DELETE FROM sync_clauses WHERE clause_type = ?`, clauseType)
+	return err
+}
@@ -0,0 +1,62 @@
+package history
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// DecompressBody reverses the compression msg applies to large message
+// bodies, returning the stored value unchanged when it is not flagged or
+// cannot be decoded
+func DecompressBody(message string, compressed int) string {
+	return decompressBody(message, compressed)
+}
+
+// Values of the `compressed` column, matching the messenger: the flag names
+// the algorithm (1 = gzip from older versions, 2 = zstd)
+const (
+	compressedNone = 0
+	compressedGzip = 1
+	compressedZstd = 2
+)
+
+func decompressBody(message string, compressed int) string {
+	if compressed == compressedNone {
+		return message
+	}
+
+	data, err := base64.StdEncoding.DecodeString(message)
+	if err != nil {
+		return message
+	}
+
+	switch compressed {
+	case compressedGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return message
+		}
+		defer reader.Close()
+		out, err := io.ReadAll(reader)
+		if err != nil {
+			return message
+		}
+		return string(out)
+	case compressedZstd:
+		reader, err := zstd.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return message
+		}
+		defer reader.Close()
+		out, err := io.ReadAll(reader)
+		if err != nil {
+			return message
+		}
+		return string(out)
+	}
+	return message
+}
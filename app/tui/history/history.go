@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/glamour"
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -18,15 +19,22 @@ type Conversation struct {
 	Agent2Dir    string
 	LastMessage  time.Time
 	MessageCount int
+	Status       string // Work-board badge set via `msg --set-status`
 }
 
 type Message struct {
+	ID           int
 	SenderName   string
 	SenderDir    string
 	ReceiverName string
 	ReceiverDir  string
 	Message      string
 	SentAt       time.Time
+	AckID        string // Set when the delivery requested an acknowledgment
+	Acked        bool
+	Status       string // "" (sent), "failed", or "detached" (session had no client)
+	ReplyTo      int    // Parent message ID when this is a threaded reply, 0 otherwise
+	Note         string // Human annotation attached from the TUI
 }
 
 type SyncClause struct {
@@ -43,6 +51,65 @@ type Model struct {
 	conversations []Conversation
 	messages      []Message
 	SelectedConv  int
+
+	// Prepared statements, reused across reloads
+	convStmt  *sql.Stmt
+	msgStmt   *sql.Stmt
+	countStmt *sql.Stmt
+
+	// Per-conversation message counts, valid while last_message_at is
+	// unchanged - avoids recounting every conversation on each reload
+	countCache map[int]countEntry
+
+	// Styled message lines in their unselected form, built once per
+	// LoadMessages so navigation doesn't re-style the whole conversation
+	styledCache []string
+
+	// Markdown rendering of message bodies, toggled with 'g' in the TUI
+	markdown   bool
+	mdRenderer *glamour.TermRenderer
+	mdWidth    int
+
+	// Per-agent color overrides from the registry (name -> hex color)
+	agentColors map[string]string
+}
+
+// SetAgentColors installs the per-agent color overrides used when styling
+// conversation lists and messages, and invalidates the styled cache
+func (m *Model) SetAgentColors(colors map[string]string) {
+	m.agentColors = colors
+	m.rebuildStyledCache()
+}
+
+// agentColor returns an agent's display color: its registry override when
+// one exists, otherwise the given default
+func (m *Model) agentColor(name string, fallback lipgloss.Color) lipgloss.Color {
+	if custom, ok := m.agentColors[name]; ok {
+		return lipgloss.Color(custom)
+	}
+	return fallback
+}
+
+// RenameAgent rewrites a registered agent's old name to its new one across
+// historical messages and conversations, so history doesn't show a mix of
+// both names after a rename. Gated behind rename_history in config.yaml.
+func (m *Model) RenameAgent(oldName, newName string) error {
+	if _, err := m.db.Exec(`UPDATE messages SET sender_name = ? WHERE sender_name = ?`, newName, oldName); err != nil {
+		return err
+	}
+	if _, err := m.db.Exec(`UPDATE messages SET receiver_name = ? WHERE receiver_name = ?`, newName, oldName); err != nil {
+		return err
+	}
+	if _, err := m.db.Exec(`UPDATE conversations SET agent1_name = ? WHERE agent1_name = ?`, newName, oldName); err != nil {
+		return err
+	}
+	_, err := m.db.Exec(`UPDATE conversations SET agent2_name = ? WHERE agent2_name = ?`, newName, oldName)
+	return err
+}
+
+type countEntry struct {
+	lastMessage time.Time
+	count       int
 }
 
 func New(dbPath string) (*Model, error) {
@@ -56,19 +123,76 @@ func New(dbPath string) (*Model, error) {
 		return nil, err
 	}
 
-	return &Model{db: db}, nil
+	// Same column migrations msg runs, in case msg hasn't touched this
+	// database since the columns were added - fails harmlessly otherwise
+	db.Exec(`ALTER TABLE messages ADD COLUMN ack_id TEXT`)
+	db.Exec(`ALTER TABLE messages ADD COLUMN acked_at TIMESTAMP`)
+	db.Exec(`ALTER TABLE messages ADD COLUMN compressed INTEGER DEFAULT 0`)
+	db.Exec(`ALTER TABLE messages ADD COLUMN status TEXT`)
+	db.Exec(`ALTER TABLE messages ADD COLUMN reply_to INTEGER`)
+	db.Exec(`ALTER TABLE conversations ADD COLUMN status TEXT`)
+
+	// Covering index so per-conversation loads and counts don't scan the
+	// whole messages table as it grows
+	db.Exec(`CREATE INDEX IF NOT EXISTS idx_messages_conversation_sent
+		ON messages(conversation_id, sent_at)`)
+
+	// Human notes attached to individual messages from the TUI
+	db.Exec(`CREATE TABLE IF NOT EXISTS annotations (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		message_id INTEGER NOT NULL UNIQUE,
+		note TEXT NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`)
+
+	// Named registry clauses for the sync editor
+	db.Exec(`CREATE TABLE IF NOT EXISTS sync_clauses (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		clause_type TEXT NOT NULL UNIQUE,
+		content TEXT NOT NULL,
+		is_active INTEGER DEFAULT 0,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`)
+
+	m := &Model{db: db, countCache: make(map[int]countEntry)}
+
+	m.convStmt, err = db.Prepare(`
+		SELECT id, agent1_name, agent1_dir, agent2_name, agent2_dir, last_message_at,
+		       COALESCE(status, '')
+		FROM conversations
+		ORDER BY last_message_at DESC
+		LIMIT 100`)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	m.msgStmt, err = db.Prepare(`
+		SELECT m.id, m.sender_name, m.sender_dir, m.receiver_name, m.receiver_dir,
+		       m.message, m.sent_at, COALESCE(m.ack_id, ''), m.acked_at IS NOT NULL,
+		       COALESCE(m.compressed, 0), COALESCE(m.status, ''), COALESCE(m.reply_to, 0),
+		       COALESCE(a.note, '')
+		FROM messages m
+		LEFT JOIN annotations a ON a.message_id = m.id
+		WHERE m.conversation_id = ?
+		ORDER BY m.sent_at ASC`)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	m.countStmt, err = db.Prepare(`SELECT COUNT(*) FROM messages WHERE conversation_id = ?`)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return m, nil
 }
 
 func (m *Model) LoadConversations() error {
-	query := `
-		SELECT c.id, c.agent1_name, c.agent1_dir, c.agent2_name, c.agent2_dir,
-		       c.last_message_at,
-		       (SELECT COUNT(*) FROM messages WHERE conversation_id = c.id) as msg_count
-		FROM conversations c
-		ORDER BY c.last_message_at DESC
-		LIMIT 100`
-
-	rows, err := m.db.Query(query)
+	rows, err := m.convStmt.Query()
 	if err != nil {
 		return err
 	}
@@ -78,25 +202,35 @@ func (m *Model) LoadConversations() error {
 	for rows.Next() {
 		var conv Conversation
 		err := rows.Scan(&conv.ID, &conv.Agent1Name, &conv.Agent1Dir,
-			&conv.Agent2Name, &conv.Agent2Dir, &conv.LastMessage, &conv.MessageCount)
+			&conv.Agent2Name, &conv.Agent2Dir, &conv.LastMessage, &conv.Status)
 		if err != nil {
 			return err
 		}
 		m.conversations = append(m.conversations, conv)
 	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
 
-	return rows.Err()
+	// Fill in message counts, recounting only conversations that have
+	// changed since the cached count was taken
+	for i := range m.conversations {
+		conv := &m.conversations[i]
+		if cached, ok := m.countCache[conv.ID]; ok && cached.lastMessage.Equal(conv.LastMessage) {
+			conv.MessageCount = cached.count
+			continue
+		}
+		if err := m.countStmt.QueryRow(conv.ID).Scan(&conv.MessageCount); err != nil {
+			return err
+		}
+		m.countCache[conv.ID] = countEntry{lastMessage: conv.LastMessage, count: conv.MessageCount}
+	}
+
+	return nil
 }
 
 func (m *Model) LoadMessages(conversationID int) error {
-	query := `
-		SELECT sender_name, sender_dir, receiver_name, receiver_dir,
-		       message, sent_at
-		FROM messages
-		WHERE conversation_id = ?
-		ORDER BY sent_at ASC`
-
-	rows, err := m.db.Query(query, conversationID)
+	rows, err := m.msgStmt.Query(conversationID)
 	if err != nil {
 		return err
 	}
@@ -105,18 +239,56 @@ func (m *Model) LoadMessages(conversationID int) error {
 	m.messages = nil
 	for rows.Next() {
 		var msg Message
-		err := rows.Scan(&msg.SenderName, &msg.SenderDir,
-			&msg.ReceiverName, &msg.ReceiverDir, &msg.Message, &msg.SentAt)
+		var compressed int
+		err := rows.Scan(&msg.ID, &msg.SenderName, &msg.SenderDir,
+			&msg.ReceiverName, &msg.ReceiverDir, &msg.Message, &msg.SentAt,
+			&msg.AckID, &msg.Acked, &compressed, &msg.Status, &msg.ReplyTo, &msg.Note)
 		if err != nil {
 			return err
 		}
+		msg.Message = decompressBody(msg.Message, compressed)
 		m.messages = append(m.messages, msg)
 	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	m.rebuildStyledCache()
+	return nil
+}
 
-	return rows.Err()
+// MessagesFor loads one conversation's messages without touching the
+// currently displayed conversation - used by the compare view, which shows
+// two conversations at once
+func (m *Model) MessagesFor(conversationID int) ([]Message, error) {
+	rows, err := m.msgStmt.Query(conversationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []Message
+	for rows.Next() {
+		var msg Message
+		var compressed int
+		err := rows.Scan(&msg.ID, &msg.SenderName, &msg.SenderDir,
+			&msg.ReceiverName, &msg.ReceiverDir, &msg.Message, &msg.SentAt,
+			&msg.AckID, &msg.Acked, &compressed, &msg.Status, &msg.ReplyTo, &msg.Note)
+		if err != nil {
+			return nil, err
+		}
+		msg.Message = decompressBody(msg.Message, compressed)
+		messages = append(messages, msg)
+	}
+	return messages, rows.Err()
 }
 
 func (m *Model) Close() {
+	for _, stmt := range []*sql.Stmt{m.convStmt, m.msgStmt, m.countStmt} {
+		if stmt != nil {
+			stmt.Close()
+		}
+	}
 	if m.db != nil {
 		m.db.Close()
 	}
@@ -151,6 +323,9 @@ func (m *Model) DeleteConversation(conversationID int) error {
 		return err
 	}
 
+	// Drop the stale cached count
+	delete(m.countCache, conversationID)
+
 	// Remove from local conversations slice
 	for i, conv := range m.conversations {
 		if conv.ID == conversationID {
@@ -186,7 +361,7 @@ func (m *Model) FormatConversationList() string {
 			prefix,
 			conv.Agent1Name,
 			conv.Agent2Name,
-			conv.LastMessage.Format("Jan 02 15:04"),
+			localized(conv.LastMessage).Format("Jan 02 15:04"),
 			conv.MessageCount)
 		lines = append(lines, line)
 	}
@@ -205,7 +380,7 @@ func (m *Model) FormatConversationListWithSelection() string {
 
 	for i, conv := range m.conversations {
 		// Calculate relative time tag
-		timeTag := getRelativeTimeTag(conv.LastMessage, now)
+		timeTag := getRelativeTimeTag(localized(conv.LastMessage), now)
 
 		// Only show time tag if it's different from the last one
 		if timeTag != lastTimeTag {
@@ -223,24 +398,51 @@ func (m *Model) FormatConversationListWithSelection() string {
 			prefix = "> "
 		}
 
-		// First agent gets baby blue, second gets green
-		styledAgent1 := lipgloss.NewStyle().Foreground(lipgloss.Color("#87CEEB")).Render(conv.Agent1Name)
-		styledAgent2 := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Render(conv.Agent2Name)
+		// First agent gets baby blue, second gets green, unless the registry
+		// assigns them custom colors
+		styledAgent1 := lipgloss.NewStyle().Foreground(m.agentColor(conv.Agent1Name, "#87CEEB")).Render(conv.Agent1Name)
+		styledAgent2 := lipgloss.NewStyle().Foreground(m.agentColor(conv.Agent2Name, "#00FF00")).Render(conv.Agent2Name)
 
-		line := fmt.Sprintf("%s%s ↔ %s",
+		line := fmt.Sprintf("%s%s ↔ %s%s",
 			prefix,
 			styledAgent1,
-			styledAgent2)
+			styledAgent2,
+			statusBadge(conv.Status))
 		lines = append(lines, line)
 	}
 
 	return strings.Join(lines, "\n")
 }
 
+// statusBadge renders the work-board status badge for a conversation
+func statusBadge(status string) string {
+	var color string
+	switch status {
+	case "blocked":
+		color = "#FF6B6B"
+	case "done":
+		color = "#00FF00"
+	case "in-progress":
+		color = "#D4AC0D"
+	default:
+		return ""
+	}
+	return " " + lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Bold(true).Render("["+status+"]")
+}
+
+// localized converts a stored timestamp to the local zone for display.
+// SQLite's CURRENT_TIMESTAMP records UTC, so storage stays UTC and the
+// conversion to wall-clock time happens only at render time.
+func localized(t time.Time) time.Time {
+	return t.In(time.Local)
+}
+
 func getRelativeTimeTag(t time.Time, now time.Time) string {
-	// Use date-based comparison for more accurate day boundaries
-	nowDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-	tDate := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	// Compare calendar dates with the midnights re-anchored in UTC, so the
+	// difference is always a whole number of days - a local-zone subtraction
+	// would truncate the 23-hour spring-forward day to zero
+	nowDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	tDate := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
 
 	daysDiff := int(nowDate.Sub(tDate).Hours() / 24)
 
@@ -268,41 +470,214 @@ func getRelativeTimeTag(t time.Time, now time.Time) string {
 	return "over a year ago"
 }
 
+// Transcript returns the loaded conversation as plain text, one line per
+// message, for export
+func (m *Model) Transcript() string {
+	var lines []string
+	for _, msg := range m.messages {
+		lines = append(lines, fmt.Sprintf("[%s] %s → %s: %s",
+			localized(msg.SentAt).Format("2006-01-02 15:04:05"),
+			msg.SenderName, msg.ReceiverName, msg.Message))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ToggleMarkdown flips markdown rendering of message bodies and restyles
+// the loaded conversation, returning the new state
+func (m *Model) ToggleMarkdown() bool {
+	m.markdown = !m.markdown
+	m.rebuildStyledCache()
+	return m.markdown
+}
+
+// SetMarkdownWidth sets the word-wrap width for rendered bodies, recreating
+// the renderer (and restyling) only when the width actually changes
+func (m *Model) SetMarkdownWidth(width int) {
+	if width <= 0 || width == m.mdWidth {
+		return
+	}
+	m.mdWidth = width
+	m.mdRenderer = nil
+	if m.markdown {
+		m.rebuildStyledCache()
+	}
+}
+
+// renderMarkdown runs one message body through glamour, falling back to the
+// raw text when rendering fails
+func (m *Model) renderMarkdown(body string) string {
+	if m.mdRenderer == nil {
+		width := m.mdWidth
+		if width <= 0 {
+			width = 80
+		}
+		renderer, err := glamour.NewTermRenderer(
+			glamour.WithAutoStyle(),
+			glamour.WithWordWrap(width),
+		)
+		if err != nil {
+			return body
+		}
+		m.mdRenderer = renderer
+	}
+
+	rendered, err := m.mdRenderer.Render(body)
+	if err != nil {
+		return body
+	}
+	return strings.TrimRight(rendered, "\n")
+}
+
+// rebuildStyledCache styles every message once, in its unselected form.
+// Format calls reuse these lines so navigation only re-styles the one
+// selected message instead of the whole conversation.
+func (m *Model) rebuildStyledCache() {
+	m.styledCache = make([]string, len(m.messages))
+	for i := range m.messages {
+		m.styledCache[i] = m.styleMessage(i, false)
+	}
+}
+
+// styleMessage renders one message line, highlighted when selected
+func (m *Model) styleMessage(i int, selected bool) string {
+	msg := m.messages[i]
+
+	// The first agent in the conversation gets baby blue for consistency;
+	// a registry color override takes precedence either way
+	senderColor := lipgloss.Color("#00FF00") // Default green
+	if len(m.messages) > 0 && msg.SenderName == m.messages[0].SenderName {
+		senderColor = lipgloss.Color("#87CEEB") // Baby blue
+	}
+	senderColor = m.agentColor(msg.SenderName, senderColor)
+
+	timestamp := localized(msg.SentAt).Format("15:04:05")
+	styledTimestamp := lipgloss.NewStyle().Foreground(lipgloss.Color("#555555")).Faint(true).Render(fmt.Sprintf("[%s]", timestamp))
+	styledSender := lipgloss.NewStyle().Foreground(senderColor).Render(msg.SenderName)
+	styledReceiver := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render(msg.ReceiverName)
+
+	// Threaded replies render indented under their parent message
+	threadPrefix := ""
+	if msg.ReplyTo != 0 {
+		threadPrefix = lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render("  ↳ ")
+	}
+
+	// Markdown mode renders the body as its own block below the header so
+	// code fences, lists, and headers agents exchange stay readable
+	if m.markdown {
+		header := fmt.Sprintf("%s%s %s → %s:%s%s",
+			threadPrefix, styledTimestamp, styledSender, styledReceiver, ackMarker(msg), statusMarker(msg))
+		if selected {
+			header = lipgloss.NewStyle().
+				Background(lipgloss.Color("#444444")).
+				Render(header)
+		}
+		return header + "\n" + m.renderMarkdown(msg.Message) + noteLine(msg)
+	}
+
+	// Selected messages show full-strength bold text, others stay faint
+	var styledMessage string
+	if selected {
+		styledMessage = lipgloss.NewStyle().Foreground(senderColor).Bold(true).Render(msg.Message)
+	} else {
+		styledMessage = lipgloss.NewStyle().Foreground(senderColor).Faint(true).Render(msg.Message)
+	}
+
+	line := fmt.Sprintf("%s%s %s → %s: %s%s%s",
+		threadPrefix,
+		styledTimestamp,
+		styledSender,
+		styledReceiver,
+		styledMessage,
+		ackMarker(msg),
+		statusMarker(msg))
+
+	if selected {
+		line = lipgloss.NewStyle().
+			Background(lipgloss.Color("#444444")).
+			Render(line)
+	}
+	return line + noteLine(msg)
+}
+
+// noteLine renders a human annotation on its own line below the message
+func noteLine(msg Message) string {
+	if msg.Note == "" {
+		return ""
+	}
+	return "\n" + lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#D4AC0D")).
+		Italic(true).
+		Render("    ✎ "+msg.Note)
+}
+
+// AnnotateMessage attaches a human note to one message; an empty note
+// removes the annotation
+func (m *Model) AnnotateMessage(messageID int, note string) error {
+	if note == "" {
+		_, err := m.db.Exec(`DELETE FROM annotations WHERE message_id = ?`, messageID)
+		return err
+	}
+	_, err := m.db.Exec(`
+		INSERT INTO annotations (message_id, note)
+		VALUES (?, ?)
+		ON CONFLICT(message_id) DO UPDATE SET note = excluded.note`, messageID, note)
+	return err
+}
+
 func (m *Model) FormatMessages() string {
 	if len(m.messages) == 0 {
 		return "No messages in this conversation"
 	}
+	return strings.Join(m.styledCache, "\n")
+}
 
-	// Get the first agent in this conversation (for consistent coloring)
-	var agent1 string
-	if len(m.messages) > 0 {
-		agent1 = m.messages[0].SenderName
+// FormatMessagesWindow renders only the messages in [start, start+count),
+// re-styling just the selected one - the visible window of a conversation
+// rather than the whole thing
+func (m *Model) FormatMessagesWindow(selectedMessage, start, count int) string {
+	if len(m.messages) == 0 {
+		return "No messages in this conversation"
 	}
 
-	var lines []string
-	for _, msg := range m.messages {
-		timestamp := msg.SentAt.Format("15:04:05")
-		styledTimestamp := lipgloss.NewStyle().Foreground(lipgloss.Color("#555555")).Faint(true).Render(fmt.Sprintf("[%s]", timestamp))
+	if start < 0 {
+		start = 0
+	}
+	end := start + count
+	if end > len(m.styledCache) {
+		end = len(m.styledCache)
+	}
 
-		// Agent1 gets baby blue, Agent2 gets green
-		senderColor := lipgloss.Color("#00FF00") // Default green
-		if msg.SenderName == agent1 {
-			senderColor = lipgloss.Color("#87CEEB") // Baby blue
+	lines := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		if i == selectedMessage {
+			lines = append(lines, m.styleMessage(i, true))
+		} else {
+			lines = append(lines, m.styledCache[i])
 		}
+	}
+	return strings.Join(lines, "\n")
+}
 
-		styledSender := lipgloss.NewStyle().Foreground(senderColor).Render(msg.SenderName)
-		styledReceiver := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render(msg.ReceiverName)
-		styledMessage := lipgloss.NewStyle().Foreground(senderColor).Faint(true).Render(msg.Message)
-
-		line := fmt.Sprintf("%s %s → %s: %s",
-			styledTimestamp,
-			styledSender,
-			styledReceiver,
-			styledMessage)
-		lines = append(lines, line)
+// statusMarker annotates messages whose delivery carried a caveat
+func statusMarker(msg Message) string {
+	switch msg.Status {
+	case "detached":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#D4AC0D")).Render(" (delivered while detached)")
+	case "failed":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#FF6B6B")).Render(" (failed)")
 	}
+	return ""
+}
 
-	return strings.Join(lines, "\n")
+// ackMarker renders pending/acked state for messages that carried an ack ID
+func ackMarker(msg Message) string {
+	if msg.AckID == "" {
+		return ""
+	}
+	if msg.Acked {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Render(" ✓")
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("#D4AC0D")).Render(" ⧗ pending")
 }
 
 func (m *Model) GetSelectedConversation() *Conversation {
@@ -329,56 +704,7 @@ func (m *Model) GetConversations() []Conversation {
 }
 
 func (m *Model) FormatMessagesWithSelection(selectedMessage int) string {
-	if len(m.messages) == 0 {
-		return "No messages in this conversation"
-	}
-
-	// Get the first agent in this conversation (for consistent coloring)
-	var agent1 string
-	if len(m.messages) > 0 {
-		agent1 = m.messages[0].SenderName
-	}
-
-	var lines []string
-	for i, msg := range m.messages {
-		timestamp := msg.SentAt.Format("15:04:05")
-		styledTimestamp := lipgloss.NewStyle().Foreground(lipgloss.Color("#555555")).Faint(true).Render(fmt.Sprintf("[%s]", timestamp))
-
-		// Agent1 gets baby blue, Agent2 gets green
-		senderColor := lipgloss.Color("#00FF00") // Default green
-		if msg.SenderName == agent1 {
-			senderColor = lipgloss.Color("#87CEEB") // Baby blue
-		}
-
-		styledSender := lipgloss.NewStyle().Foreground(senderColor).Render(msg.SenderName)
-		styledReceiver := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render(msg.ReceiverName)
-
-		// Show full message for selected, normal for others
-		var styledMessage string
-		if i == selectedMessage {
-			// Full message, bold and highlighted
-			styledMessage = lipgloss.NewStyle().Foreground(senderColor).Bold(true).Render(msg.Message)
-		} else {
-			styledMessage = lipgloss.NewStyle().Foreground(senderColor).Faint(true).Render(msg.Message)
-		}
-
-		line := fmt.Sprintf("%s %s → %s: %s",
-			styledTimestamp,
-			styledSender,
-			styledReceiver,
-			styledMessage)
-
-		// Highlight selected message background
-		if i == selectedMessage {
-			line = lipgloss.NewStyle().
-				Background(lipgloss.Color("#444444")).
-				Render(line)
-		}
-
-		lines = append(lines, line)
-	}
-
-	return strings.Join(lines, "\n")
+	return m.FormatMessagesWindow(selectedMessage, 0, len(m.styledCache))
 }
 
 
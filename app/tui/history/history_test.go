@@ -0,0 +1,68 @@
+package history
+
+import (
+	"testing"
+	"time"
+)
+
+// newYork loads a DST-observing zone, skipping when the host has no tzdata
+func newYork(t *testing.T) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("no tzdata available:", err)
+	}
+	return loc
+}
+
+// TestRelativeTimeTagAcrossSpringForward checks that the 23-hour day when
+// clocks jump forward still counts as exactly one calendar day
+func TestRelativeTimeTagAcrossSpringForward(t *testing.T) {
+	loc := newYork(t)
+
+	// US DST began 2025-03-09 at 02:00 local, making that day 23 hours
+	// long: a message sent on it must tag "yesterday" the next day even
+	// though less than 24 hours separate the two midnights
+	sent := time.Date(2025, 3, 9, 8, 0, 0, 0, loc)
+	now := time.Date(2025, 3, 10, 7, 0, 0, 0, loc)
+
+	if tag := getRelativeTimeTag(sent, now); tag != "yesterday" {
+		t.Errorf("sent on spring-forward day: got %q, want %q", tag, "yesterday")
+	}
+
+	// Crossing into the transition day still counts one day
+	sent = time.Date(2025, 3, 8, 23, 30, 0, 0, loc)
+	now = time.Date(2025, 3, 9, 8, 0, 0, 0, loc)
+
+	if tag := getRelativeTimeTag(sent, now); tag != "yesterday" {
+		t.Errorf("sent before spring-forward day: got %q, want %q", tag, "yesterday")
+	}
+}
+
+// TestRelativeTimeTagAcrossFallBack checks that the 25-hour day when clocks
+// fall back does not push a same-day message into "yesterday"
+func TestRelativeTimeTagAcrossFallBack(t *testing.T) {
+	loc := newYork(t)
+
+	// US DST ended 2025-11-02 at 02:00 local
+	sent := time.Date(2025, 11, 2, 0, 30, 0, 0, loc)
+	now := time.Date(2025, 11, 2, 23, 30, 0, 0, loc)
+
+	if tag := getRelativeTimeTag(sent, now); tag != "today" {
+		t.Errorf("fall-back: got %q, want %q", tag, "today")
+	}
+}
+
+// TestLocalizedPreservesInstant ensures render-time conversion changes the
+// zone but never the underlying instant
+func TestLocalizedPreservesInstant(t *testing.T) {
+	utc := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	local := localized(utc)
+	if !local.Equal(utc) {
+		t.Errorf("localized shifted the instant: %v vs %v", local, utc)
+	}
+	if local.Location() != time.Local {
+		t.Errorf("localized returned zone %v, want local", local.Location())
+	}
+}
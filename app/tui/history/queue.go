@@ -0,0 +1,66 @@
+package history
+
+import "fmt"
+
+// Queue operations: messages whose delivery carried a caveat ("failed",
+// "detached", or "dnd") form the queue the TUI's Queue view manages. Retrying
+// a stuck message re-sends it through msg, which logs a fresh row, so a
+// successful retry drops the stale one rather than clearing its status.
+
+// QueuedMessages returns messages with a failed, detached, or do-not-disturb
+// delivery status, newest first, for the Queue view.
+func (m *Model) QueuedMessages() ([]Message, error) {
+	if m.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	rows, err := m.db.Query(`
+		SELECT id, sender_name, COALESCE(sender_dir, ''), receiver_name,
+		       COALESCE(receiver_dir, ''), message, sent_at,
+		       COALESCE(compressed, 0), COALESCE(status, '')
+		FROM messages
+		WHERE status IN ('failed', 'detached', 'dnd')
+		ORDER BY sent_at DESC
+		LIMIT 100`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var queued []Message
+	for rows.Next() {
+		var msg Message
+		var compressed int
+		if err := rows.Scan(&msg.ID, &msg.SenderName, &msg.SenderDir,
+			&msg.ReceiverName, &msg.ReceiverDir, &msg.Message, &msg.SentAt,
+			&compressed, &msg.Status); err != nil {
+			return nil, err
+		}
+		msg.Message = decompressBody(msg.Message, compressed)
+		queued = append(queued, msg)
+	}
+	return queued, rows.Err()
+}
+
+// UpdateMessageBody replaces a queued message's text before a retry. The
+// stored body is left uncompressed so the queue view shows what will be sent.
+func (m *Model) UpdateMessageBody(messageID int, body string) error {
+	if m.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+	_, err := m.db.Exec(`UPDATE messages SET message = ?, compressed = 0 WHERE id = ?`, body, messageID)
+	return err
+}
+
+// DeleteMessage removes a single message (dropping a queue entry, or clearing
+// the stale row after a successful retry).
+func (m *Model) DeleteMessage(messageID int) error {
+	if m.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+	if _, err := m.db.Exec(`DELETE FROM annotations WHERE message_id = ?`, messageID); err != nil {
+		return err
+	}
+	_, err := m.db.Exec(`DELETE FROM messages WHERE id = ?`, messageID)
+	return err
+}
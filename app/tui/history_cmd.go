@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"slaygent-manager/history"
+)
+
+// `slay history <agentA> <agentB>` prints the conversation between two agents
+// without launching the TUI, for a quick check from another pane. --tail
+// limits how far back it starts (default 20 messages) and --follow keeps
+// polling for new messages until interrupted.
+
+func runHistoryCommand(args []string) {
+	var agentA, agentB string
+	tail := 20
+	follow := false
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--tail":
+			if i+1 >= len(args) {
+				fmt.Fprintln(os.Stderr, "Error: --tail requires a count")
+				os.Exit(1)
+			}
+			i++
+			n, err := strconv.Atoi(args[i])
+			if err != nil || n <= 0 {
+				fmt.Fprintf(os.Stderr, "Error: invalid --tail count %q\n", args[i])
+				os.Exit(1)
+			}
+			tail = n
+		case "--follow", "-f":
+			follow = true
+		default:
+			if agentA == "" {
+				agentA = args[i]
+			} else if agentB == "" {
+				agentB = args[i]
+			} else {
+				fmt.Fprintln(os.Stderr, "Usage: slay history <agentA> <agentB> [--tail 20] [--follow]")
+				os.Exit(1)
+			}
+		}
+	}
+	if agentA == "" || agentB == "" {
+		fmt.Fprintln(os.Stderr, "Usage: slay history <agentA> <agentB> [--tail 20] [--follow]")
+		os.Exit(1)
+	}
+
+	db, err := openEventsDB()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	// Print the last `tail` messages, oldest first
+	rows, err := db.Query(`
+		SELECT m.id, m.sender_name, m.receiver_name, m.message,
+		       datetime(m.sent_at, 'localtime'), COALESCE(m.compressed, 0)
+		FROM messages m
+		WHERE (m.sender_name = ? AND m.receiver_name = ?)
+		   OR (m.sender_name = ? AND m.receiver_name = ?)
+		ORDER BY m.sent_at DESC LIMIT ?`,
+		agentA, agentB, agentB, agentA, tail)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	type histLine struct {
+		id                     int
+		sender, receiver, body string
+		sentAt                 string
+	}
+	var lines []histLine
+	lastID := 0
+	for rows.Next() {
+		var line histLine
+		var compressed int
+		if err := rows.Scan(&line.id, &line.sender, &line.receiver, &line.body, &line.sentAt, &compressed); err != nil {
+			continue
+		}
+		line.body = history.DecompressBody(line.body, compressed)
+		lines = append(lines, line)
+	}
+	rows.Close()
+
+	if len(lines) == 0 && !follow {
+		fmt.Printf("No messages between %s and %s\n", agentA, agentB)
+		return
+	}
+
+	// Query returned newest first - print in conversation order
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := lines[i]
+		fmt.Printf("[%s] %s → %s: %s\n", line.sentAt, line.sender, line.receiver, line.body)
+		if line.id > lastID {
+			lastID = line.id
+		}
+	}
+
+	if !follow {
+		return
+	}
+
+	// Poll for anything newer until interrupted
+	for {
+		time.Sleep(2 * time.Second)
+
+		rows, err := db.Query(`
+			SELECT m.id, m.sender_name, m.receiver_name, m.message,
+			       datetime(m.sent_at, 'localtime'), COALESCE(m.compressed, 0)
+			FROM messages m
+			WHERE m.id > ?
+			  AND ((m.sender_name = ? AND m.receiver_name = ?)
+			    OR (m.sender_name = ? AND m.receiver_name = ?))
+			ORDER BY m.sent_at ASC`,
+			lastID, agentA, agentB, agentB, agentA)
+		if err != nil {
+			continue
+		}
+		for rows.Next() {
+			var line histLine
+			var compressed int
+			if err := rows.Scan(&line.id, &line.sender, &line.receiver, &line.body, &line.sentAt, &compressed); err != nil {
+				continue
+			}
+			line.body = history.DecompressBody(line.body, compressed)
+			fmt.Printf("[%s] %s → %s: %s\n", line.sentAt, line.sender, line.receiver, line.body)
+			if line.id > lastID {
+				lastID = line.id
+			}
+		}
+		rows.Close()
+	}
+}
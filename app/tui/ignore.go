@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// Persisted ignore list hiding specific panes from the agents table -
+// long-running personal assistants that should never be registered or
+// messaged. Stored at ~/.slaygent/ignore.json, edited with 'H' on a row
+// ('ctrl+h' temporarily shows hidden rows so they can be unhidden).
+
+type ignoreEntry struct {
+	Directory string `json:"directory"`
+	Machine   string `json:"machine"`
+}
+
+// ignorePath returns the ignore list location, creating the directory
+func ignorePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	slaygentDir := filepath.Join(home, ".slaygent")
+	os.MkdirAll(slaygentDir, 0755)
+	return filepath.Join(slaygentDir, "ignore.json")
+}
+
+// loadIgnoreList reads the persisted ignore entries, empty when absent
+func loadIgnoreList() []ignoreEntry {
+	path := ignorePath()
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var entries []ignoreEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil
+	}
+	return entries
+}
+
+// saveIgnoreList persists the ignore entries
+func saveIgnoreList(entries []ignoreEntry) error {
+	path := ignorePath()
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// isIgnored reports whether a directory+machine pair is on the ignore list
+func isIgnored(entries []ignoreEntry, directory, machine string) bool {
+	for _, entry := range entries {
+		if entry.Directory == directory && entry.Machine == machine {
+			return true
+		}
+	}
+	return false
+}
+
+// toggleIgnoreEntry adds or removes one directory+machine pair, returning
+// whether the pair is now ignored
+func toggleIgnoreEntry(directory, machine string) bool {
+	entries := loadIgnoreList()
+	for i, entry := range entries {
+		if entry.Directory == directory && entry.Machine == machine {
+			saveIgnoreList(append(entries[:i], entries[i+1:]...))
+			return false
+		}
+	}
+	saveIgnoreList(append(entries, ignoreEntry{Directory: directory, Machine: machine}))
+	return true
+}
+
+// filterIgnoredRows drops agents-table rows matching the ignore list
+func filterIgnoredRows(rows [][]string, entries []ignoreEntry) [][]string {
+	if len(entries) == 0 {
+		return rows
+	}
+
+	var kept [][]string
+	for _, row := range rows {
+		if len(row) >= 7 && isIgnored(entries, row[1], row[5]) {
+			continue
+		}
+		kept = append(kept, row)
+	}
+	return kept
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Pane jump ('o' in the agents view): moves the operator straight to the
+// selected agent's pane. Local rows carry a tmux target, so switch-client
+// lands on the pane directly; remote rows suspend the TUI and attach to the
+// machine's tmux session over SSH.
+
+// paneJumpMsg reports the result of a suspended SSH attach
+type paneJumpMsg struct {
+	err error
+}
+
+// jumpToPane switches the operator to the pane behind one agents-table row
+func (m model) jumpToPane(row []string) (model, tea.Cmd) {
+	paneID := row[0]
+	machine := row[5]
+
+	if isRegistrableMachine(machine) {
+		// switch-client only works from inside a tmux client
+		if os.Getenv("TMUX") == "" {
+			return m.showToast("Pane jump needs slay running inside tmux")
+		}
+		countSubprocess()
+		if err := exec.Command("tmux", "switch-client", "-t", paneID).Run(); err != nil {
+			return m.showToast("Pane jump failed: " + err.Error())
+		}
+		countSubprocess()
+		exec.Command("tmux", "select-pane", "-t", paneID).Run()
+		return m.showToast("Jumped to " + paneID)
+	}
+
+	if m.sshRegistry == nil {
+		return m.showToast("SSH registry unavailable")
+	}
+	conn := m.sshRegistry.GetConnection(machine)
+	if conn == nil {
+		return m.showToast("No SSH connection named " + machine)
+	}
+	sshParts := sshCommandParts(*conn)
+	if sshParts == nil {
+		return m.showToast("SSH connect command for " + machine + " is empty")
+	}
+
+	// Force a tty so the remote tmux attach is interactive; the TUI suspends
+	// until the operator detaches
+	args := append([]string{"-t"}, sshParts[1:]...)
+	args = append(args, "tmux attach")
+	cmd := exec.Command(sshParts[0], args...)
+	countSubprocess()
+	return m, tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return paneJumpMsg{err: err}
+	})
+}
+
+// paneJumpResult turns a finished SSH attach into operator feedback
+func (m model) paneJumpResult(msg paneJumpMsg) (model, tea.Cmd) {
+	if msg.err != nil {
+		return m.showToast(fmt.Sprintf("SSH attach failed: %v", msg.err))
+	}
+	return m, nil
+}
@@ -0,0 +1,65 @@
+package main
+
+// Configurable keybindings: a "keymap" section in config.yaml maps action
+// names to keys (e.g. "messages: M"), and every single-key view action goes
+// through keyMap.translate before the Update() key switch, so keys can be
+// remapped without recompiling. Navigation, ESC and quit stay fixed.
+
+// defaultKeyBindings names each remappable action and its built-in key; the
+// Update() cases still switch on these default keys
+var defaultKeyBindings = map[string]string{
+	"register":    "a",
+	"ssh_connect": "z",
+	"ssh_manage":  "x",
+	"refresh":     "r",
+	"sync":        "e",
+	"quick_sync":  "s",
+	"messages":    "m",
+	"activity":    "v",
+	"tasks":       "t",
+	"logs":        "L",
+	"doctor":      "D",
+	"preview":     "P",
+	"queue":       "Q",
+	"metrics":     "M",
+	"jump":        "o",
+	"live":        "l",
+	"compare":     "c",
+	"groups":      "G",
+	"color":       "C",
+	"dnd":         "Z",
+	"help":        "?",
+}
+
+// keyMap translates pressed keys to the default keys Update() switches on
+type keyMap struct {
+	translation map[string]string
+}
+
+// newKeyMap builds the translation table from the configured overrides;
+// unknown action names are ignored so a typo doesn't break the defaults
+func newKeyMap(overrides map[string]string) keyMap {
+	translation := make(map[string]string)
+
+	// A remapped action's default key no longer triggers it
+	for action, key := range overrides {
+		if def, ok := defaultKeyBindings[action]; ok && key != "" && key != def {
+			translation[def] = ""
+		}
+	}
+	for action, key := range overrides {
+		if def, ok := defaultKeyBindings[action]; ok && key != "" {
+			translation[key] = def
+		}
+	}
+	return keyMap{translation: translation}
+}
+
+// translate maps a pressed key to the default key of the action bound to it;
+// unmapped keys pass through, and freed default keys become inert
+func (k keyMap) translate(key string) string {
+	if mapped, ok := k.translation[key]; ok {
+		return mapped
+	}
+	return key
+}
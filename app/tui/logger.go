@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Debug logging: errors that would otherwise vanish behind `err == nil`
+// checks (SSH failures, sync errors, DB warnings) are appended to
+// ~/.slaygent/logs/slay.log and viewable in the TUI Logs view (L key).
+
+// debugLogPath returns the log file location, creating the directory
+func debugLogPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	logsDir := filepath.Join(home, ".slaygent", "logs")
+	os.MkdirAll(logsDir, 0755)
+	return filepath.Join(logsDir, "slay.log")
+}
+
+// debugLog appends one structured line: timestamp, level, component, message.
+// Best effort - logging failures are ignored.
+func debugLog(level, component, message string) {
+	path := debugLogPath()
+	if path == "" {
+		return
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	fmt.Fprintf(f, "%s [%s] %s: %s\n",
+		time.Now().Format("2006-01-02 15:04:05"), level, component, message)
+}
+
+// debugLogError records an error with its component when err is non-nil
+func debugLogError(component string, err error) {
+	if err == nil {
+		return
+	}
+	debugLog("ERROR", component, err.Error())
+}
+
+// readDebugLog returns the last maxLines lines of the log, oldest first
+func readDebugLog(maxLines int) []string {
+	path := debugLogPath()
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil // No log yet
+	}
+
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) > maxLines {
+		lines = lines[len(lines)-maxLines:]
+	}
+	return lines
+}
@@ -1,11 +1,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -13,6 +14,7 @@ import (
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/evertras/bubble-table/table"
@@ -26,7 +28,7 @@ type model struct {
 	registry    *Registry
 	sshRegistry *SSHRegistry
 	inputMode   bool   // Are we in input mode?
-	inputBuffer string // What the user is typing
+	textInput   textinput.Model // What the user is typing (cursor movement, paste, unicode)
 	inputTarget string // What we're inputting for (e.g., "register", "ssh-name", "ssh-key", "ssh-key-picker", "ssh-command")
 	syncing     bool   // Are we currently syncing?
 	syncMessage string // Message to show after sync completes
@@ -38,6 +40,23 @@ type model struct {
 	selectedMessage int // Selected message index when in messages panel
 	deleteConfirm bool // Are we in delete confirmation mode?
 	deleteTarget int   // Which conversation ID to delete
+	liveTail     bool  // Poll messages.db and auto-scroll ('l' in messages view)
+
+	// Keybinding translation from the config.yaml keymap section
+	keys keyMap
+
+	// messages.db failed to open; don't retry on every use
+	historyFailed bool
+
+	// Agents-table filter ('/'): narrows rows by name, directory, type or
+	// machine as the query is typed
+	filterMode  bool
+	filterQuery string
+
+	// Conversation quick-switcher (ctrl+k in messages view)
+	switcherMode  bool
+	switcherQuery string
+	switcherIndex int
 
 	// Sync customization fields
 	syncEditor       textarea.Model
@@ -48,6 +67,65 @@ type model struct {
 	// Help view
 	helpModel *views.HelpModel
 
+	// Activity (audit log) view
+	activityEvents  []views.ActivityEvent
+	activityPending []views.PendingReply
+
+	// Per-agent traffic metrics ('M')
+	metricsAgents []views.AgentMetrics
+
+	// Conversation compare ('c' in messages view): the first marked
+	// conversation, and both sides once the second is chosen
+	compareFirstID    int
+	compareFirstTitle string
+	compareData       views.CompareViewData
+
+	// Queue view of failed/detached deliveries
+	queueItems []views.QueueItem
+	queueIndex int
+
+	// No local tmux server - agents table is limited but messages, sync,
+	// and SSH management stay available
+	degradedMode bool
+
+	// Discovered plugins (views, table columns, msg subcommands)
+	plugins        []Plugin
+	pluginSections []views.PluginSection
+
+	// Tasks view
+	taskItems []views.TaskItem
+
+	// Debug logs view
+	logLines []string
+
+	// Diagnostics view
+	diagChecks []views.DiagCheck
+
+	// Pane preview view
+	previewTitle   string
+	previewContent string
+
+	// SSH machine resource readouts (machine name -> "load ... | mem ...")
+	machineResources map[string]string
+
+	// User configuration from ~/.slaygent/config.json
+	config tuiConfig
+
+	// Quit guard: set when 'q' is pressed while a sync is in flight
+	quitConfirm bool
+
+	// Transient toast notification (toastSeq invalidates stale expiry timers)
+	toastText string
+	toastSeq  int
+
+	// Newer release tag from the background update check, "" when current
+	updateNotice string
+
+	// Async refresh state: sources still in flight and last rows per source
+	refreshPending map[string]bool
+	sourceRows     map[string][][]string
+	refreshSpinner spinner.Model
+
 	// SSH connection being built
 	tempSSHName    string
 	tempSSHKey     string
@@ -62,11 +140,25 @@ type model struct {
 	sshDeleteConfirm bool
 	sshDeleteTarget  int
 
+	// Ignore list hiding panes/directories from the agents table
+	ignoreList  []ignoreEntry
+	showIgnored bool // 'ctrl+h' temporarily shows hidden rows
+
+	// Message being annotated via the 'N' prompt in the messages view
+	annotateMessageID int
+
+	// Efficiency audit overlay ('ctrl+t')
+	auditMode           bool
+	lastUpdateDuration  time.Duration
+	lastRefreshSubprocs int64
+
 	// File picker for custom sync
 	filePickerMode     bool
+	collapsedProjects  map[string]bool // Folded project groups in the picker
 	discoveredFiles    []DiscoveredFile
 	filePickerIndex    int
 	filePickerLoading  bool
+	pickerShowIgnored  bool // Include gitignored/junk-dir files in discovery
 	filePickerError    string
 	filePickerSpinners []spinner.Model // Multiple spinners for fun!
 
@@ -77,17 +169,80 @@ type model struct {
 	syncProgressActive  bool
 	syncProgressError   string
 	syncProgressSpinner spinner.Model
+	syncCancel          context.CancelFunc // Cancels the in-flight sync (ESC)
+	syncClauseName      string             // Loaded named clause ("" = unsaved)
 
 	width       int // Terminal width
 	height      int // Terminal height
 }
 
 func (m model) Init() tea.Cmd {
-	// Set window title and disable auto-refresh to prevent duplication
-	return tea.SetWindowTitle("Slaygent Manager")
+	// Set window title and disable auto-refresh to prevent duplication;
+	// remote machines are queried in the background so the local table
+	// renders immediately
+	return tea.Batch(tea.SetWindowTitle("Slaygent Manager"), checkForUpdateCmd, remoteRowsCmd(m.sshRegistry))
+}
+
+// remoteRowsMsg carries the remote machines' agent rows once the background
+// SSH queries finish
+type remoteRowsMsg struct {
+	rows [][]string
+}
+
+// remoteRowsCmd queries every SSH machine's registry off the UI thread
+func remoteRowsCmd(sshRegistry *SSHRegistry) tea.Cmd {
+	return func() tea.Msg {
+		if sshRegistry == nil {
+			return remoteRowsMsg{}
+		}
+		var rows [][]string
+		for _, conn := range sshRegistry.GetConnections() {
+			rows = append(rows, remoteRegistryRows(conn)...)
+		}
+		return remoteRowsMsg{rows: rows}
+	}
+}
+
+// ensureHistory opens messages.db on first use, keeping it off the cold
+// start path; a failed open is remembered so it isn't retried every keypress
+func (m model) ensureHistory() model {
+	if m.historyModel != nil || m.historyFailed {
+		return m
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		m.historyFailed = true
+		return m
+	}
+	historyModel, err := history.New(filepath.Join(home, ".slaygent", "messages.db"))
+	if err != nil {
+		// Continue without history - Messages view will show "Database unavailable"
+		m.historyFailed = true
+		debugLogError("db", err)
+		return m
+	}
+
+	// Load initial conversations with any per-agent color overrides
+	if m.registry != nil {
+		historyModel.SetAgentColors(m.registry.ColorOverrides())
+	}
+	historyModel.LoadConversations()
+	m.historyModel = historyModel
+	return m
 }
 
 // initializeSyncComponents sets up the sync customization components
+// newPromptInput returns a focused single-line input for the registration
+// prompts, replacing the old append/backspace-only buffer
+func newPromptInput() textinput.Model {
+	ti := textinput.New()
+	ti.Focus()
+	ti.CharLimit = 128
+	ti.Width = 50
+	return ti
+}
+
 func (m model) initializeSyncComponents() model {
 	if m.syncHelp.Width == 0 { // Check if already initialized
 		m.syncEditor = views.BuildSyncEditor(
@@ -96,10 +251,52 @@ func (m model) initializeSyncComponents() model {
 		)
 		m.syncMode = views.ViewMode
 		m.syncHelp = help.New()
+
+		// Resume the active named clause from the last session
+		if m.historyModel != nil {
+			if clause, _ := m.historyModel.ActiveSyncClause(); clause != nil {
+				m.syncEditor.SetValue(clause.Content)
+				m.syncClauseName = clause.ClauseType
+			}
+		}
 	}
 	return m
 }
 
+// switchSyncClause loads the previous (-1) or next (+1) stored named clause
+// into the sync editor and marks it active
+func (m model) switchSyncClause(direction int) (tea.Model, tea.Cmd) {
+	if m.historyModel == nil {
+		return m, nil
+	}
+
+	clauses, err := m.historyModel.SyncClauses()
+	if err != nil || len(clauses) == 0 {
+		m, toastCmd := m.showToast("No saved clauses - 'w' to save one")
+		return m, toastCmd
+	}
+
+	// Step from the currently loaded clause, or from the edge when the
+	// editor holds unsaved content
+	current := -1
+	for i, clause := range clauses {
+		if clause.ClauseType == m.syncClauseName {
+			current = i
+			break
+		}
+	}
+	next := (current + direction + len(clauses)) % len(clauses)
+
+	clause := clauses[next]
+	m.syncEditor.SetValue(clause.Content)
+	m.syncClauseName = clause.ClauseType
+	m.syncModified = false
+	m.historyModel.SetActiveSyncClause(clause.ClauseType)
+
+	m, toastCmd := m.showToast(fmt.Sprintf("Clause '%s' (%d/%d)", clause.ClauseType, next+1, len(clauses)))
+	return m, toastCmd
+}
+
 // getSSHKeys returns a list of SSH key files from ~/.ssh directory
 func getSSHKeys() []string {
 	home, err := os.UserHomeDir()
@@ -134,6 +331,9 @@ func getSSHKeys() []string {
 }
 
 type refreshMsg struct{}
+
+// liveTailMsg is the poll tick for live tail mode in the messages view
+type liveTailMsg time.Time
 type syncCompleteMsg struct{
 	filesUpdated int
 }
@@ -147,6 +347,79 @@ type resetProgressMsg struct{}
 
 
 func (m model) View() string {
+	atomic.AddInt64(&renderCount, 1)
+	view := m.viewContent()
+	if m.auditMode {
+		view += "\n" + m.auditLine() + "\n"
+	}
+	if status := m.refreshStatusLine(); status != "" && m.viewMode == "agents" {
+		view += "\n" + status + "\n"
+	}
+	if m.viewMode == "agents" {
+		footer := versionString()
+		if m.updateNotice != "" {
+			footer += "  •  update available: " + m.updateNotice
+		}
+		view += "\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("#666666")).Render(footer) + "\n"
+	}
+	if m.viewMode == "agents" && (m.filterMode || m.filterQuery != "") {
+		prompt := lipgloss.NewStyle().Foreground(lipgloss.Color("#C71585")).Bold(true).Render("Filter: ")
+		line := prompt + m.filterQuery
+		if m.filterMode {
+			line += "█"
+		} else {
+			line += lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render("  (ESC clears)")
+		}
+		view += "\n" + line + "\n"
+	}
+	if m.switcherMode {
+		prompt := lipgloss.NewStyle().Foreground(lipgloss.Color("#C71585")).Bold(true).Render("Switch to: ")
+		lines := []string{prompt + m.switcherQuery + "█"}
+		matches := m.switcherMatches()
+		for n, idx := range matches {
+			if n >= 8 {
+				break
+			}
+			conv := m.historyModel.GetConversations()[idx]
+			label := conv.Agent1Name + " ↔ " + conv.Agent2Name
+			if n == m.switcherIndex {
+				label = "> " + lipgloss.NewStyle().Bold(true).Render(label)
+			} else {
+				label = "  " + label
+			}
+			lines = append(lines, label)
+		}
+		if len(matches) == 0 {
+			lines = append(lines, lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render("  No matching conversations"))
+		}
+		view += "\n" + strings.Join(lines, "\n") + "\n"
+	}
+	if m.inputMode && m.inputTarget == "annotate" {
+		prompt := lipgloss.NewStyle().Foreground(lipgloss.Color("#C71585")).Bold(true).Render("Note: ")
+		view += "\n" + prompt + m.textInput.View() + "\n"
+	}
+	if m.inputMode && m.inputTarget == "queue-edit" {
+		prompt := lipgloss.NewStyle().Foreground(lipgloss.Color("#C71585")).Bold(true).Render("Edit message: ")
+		view += "\n" + prompt + m.textInput.View() + "\n"
+	}
+	if m.inputMode && m.inputTarget == "template" {
+		prompt := lipgloss.NewStyle().Foreground(lipgloss.Color("#C71585")).Bold(true).Render("Template: ")
+		view += "\n" + prompt + m.textInput.View() + "\n"
+	}
+	if m.toastText != "" {
+		view += "\n" + views.RenderToast(m.toastText, m.width) + "\n"
+	}
+	if m.quitConfirm {
+		prompt := lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF6B6B")).
+			Bold(true).
+			Render("A sync is still running - quit anyway and risk half-synced files? (y/n)")
+		view += "\n" + prompt + "\n"
+	}
+	return view
+}
+
+func (m model) viewContent() string {
 	// Show help view if active
 	if m.viewMode == "help" {
 		if m.helpModel != nil {
@@ -157,18 +430,33 @@ func (m model) View() string {
 
 	// Show file picker if active (takes precedence over sync view)
 	if m.filePickerMode {
-		// Convert to views.DiscoveredFile slice
-		var viewFiles []views.DiscoveredFile
-		for _, f := range m.discoveredFiles {
-			viewFiles = append(viewFiles, views.DiscoveredFile{
-				Path:      f.Path,
-				Type:      f.Type,
-				Directory: f.Directory,
-				Selected:  f.Selected,
+		// Convert rows (project headers + files) to the views layer
+		var viewRows []views.PickerRow
+		for _, r := range buildPickerRows(m.discoveredFiles, m.collapsedProjects) {
+			if r.header {
+				selected, total := groupCounts(m.discoveredFiles, r.project)
+				viewRows = append(viewRows, views.PickerRow{
+					Header:    true,
+					Project:   r.project,
+					Collapsed: m.collapsedProjects[r.project],
+					Selected:  selected,
+					Total:     total,
+				})
+				continue
+			}
+			f := m.discoveredFiles[r.fileIndex]
+			viewRows = append(viewRows, views.PickerRow{
+				File: views.DiscoveredFile{
+					Path:      f.Path,
+					Type:      f.Type,
+					Directory: f.Directory,
+					Selected:  f.Selected,
+					Missing:   f.Missing,
+				},
 			})
 		}
 		return views.RenderFilePicker(
-			viewFiles,
+			viewRows,
 			m.filePickerIndex,
 			m.filePickerLoading,
 			m.filePickerError,
@@ -194,9 +482,13 @@ func (m model) View() string {
 	// Show sync view if active
 	if m.viewMode == "sync" {
 		return views.RenderSyncView(views.SyncViewData{
-			Editor:   m.syncEditor,
-			Mode:     m.syncMode,
-			Modified: m.syncModified,
+			Editor:     m.syncEditor,
+			Mode:       m.syncMode,
+			Modified:   m.syncModified,
+			Preview:    expandClauseTemplate(m.syncEditor.Value()),
+			ClauseName: m.syncClauseName,
+			InputMode:  m.inputMode && m.inputTarget == "clause-name",
+			InputBuffer: m.textInput.View(),
 			Help:     m.syncHelp,
 			Width:    m.width,
 			Height:   m.height,
@@ -212,6 +504,7 @@ func (m model) View() string {
 					Name:           conn.Name,
 					SSHKey:         conn.SSHKey,
 					ConnectCommand: conn.ConnectCommand,
+					Resources:      m.machineResources[conn.Name],
 				})
 			}
 		}
@@ -226,6 +519,82 @@ func (m model) View() string {
 		})
 	}
 
+	// Show activity (audit log) view if active
+	if m.viewMode == "activity" {
+		return views.RenderActivityView(views.ActivityViewData{
+			Events:  m.activityEvents,
+			Pending: m.activityPending,
+			Width:   m.width,
+		})
+	}
+
+	// Show per-agent metrics view if active
+	if m.viewMode == "metrics" {
+		return views.RenderMetricsView(views.MetricsViewData{
+			Agents: m.metricsAgents,
+			Window: "last 24h",
+			Width:  m.width,
+		})
+	}
+
+	// Show side-by-side conversation compare if active
+	if m.viewMode == "compare" {
+		data := m.compareData
+		data.Width = m.width
+		return views.RenderCompareView(data)
+	}
+
+	// Show message queue view if active
+	if m.viewMode == "queue" {
+		return views.RenderQueueView(views.QueueViewData{
+			Items:    m.queueItems,
+			Selected: m.queueIndex,
+			Width:    m.width,
+		})
+	}
+
+	// Show tasks view if active
+	if m.viewMode == "tasks" {
+		return views.RenderTasksView(views.TasksViewData{
+			Tasks: m.taskItems,
+			Width: m.width,
+		})
+	}
+
+	// Show diagnostics view if active
+	if m.viewMode == "doctor" {
+		return views.RenderDoctorView(views.DoctorViewData{
+			Checks: m.diagChecks,
+			Width:  m.width,
+		})
+	}
+
+	// Show pane preview view if active
+	if m.viewMode == "preview" {
+		return views.RenderPreviewView(views.PreviewViewData{
+			Title:   m.previewTitle,
+			Content: m.previewContent,
+			Width:   m.width,
+			Height:  m.height,
+		})
+	}
+
+	// Show debug logs view if active
+	if m.viewMode == "logs" {
+		return views.RenderLogsView(views.LogsViewData{
+			Lines: m.logLines,
+			Width: m.width,
+		})
+	}
+
+	// Show plugins view if active
+	if m.viewMode == "plugins" {
+		return views.RenderPluginsView(views.PluginsViewData{
+			Sections: m.pluginSections,
+			Width:    m.width,
+		})
+	}
+
 	// Show messages view if active
 	if m.viewMode == "messages" {
 		return views.RenderMessagesView(views.MessagesViewData{
@@ -290,7 +659,7 @@ func (m model) View() string {
 		Registry:      m.registry,
 		SSHConnCount:  sshConnCount,
 		InputMode:     m.inputMode,
-		InputBuffer:   m.inputBuffer,
+		InputBuffer:   m.textInput.View(),
 		InputTarget:   m.inputTarget,
 		TempSSHName:   m.tempSSHName,
 		TempSSHKey:    m.tempSSHKey,
@@ -298,100 +667,99 @@ func (m model) View() string {
 		SyncMessage:   m.syncMessage,
 		Progress:      m.progress,
 		Width:         m.width,
+		Heartbeats:    heartbeatLabels(),
+		Degraded:      m.degradedMode,
 	})
 }
 
-// findSyncScript returns the path to the sync script, checking multiple locations
-func findSyncScript(scriptName string) string {
-	// PRIORITY 1: Dynamic Homebrew detection (works on any machine)
-	if brewPrefix := getHomebrewPrefix(); brewPrefix != "" {
-		// Check lib location FIRST (stable, version-independent)
-		libPath := filepath.Join(brewPrefix, "lib", "slaygent-comms", scriptName)
-		if _, err := os.Stat(libPath); err == nil {
-			return libPath
-		}
-
-		// Check Cellar as fallback (for older versions)
-		cellarBase := filepath.Join(brewPrefix, "Cellar", "slaygent-comms")
-		if entries, err := os.ReadDir(cellarBase); err == nil {
-			for _, entry := range entries {
-				if entry.IsDir() {
-					dynamicPath := filepath.Join(cellarBase, entry.Name(), "libexec", scriptName)
-					if _, err := os.Stat(dynamicPath); err == nil {
-						return dynamicPath
-					}
-				}
-			}
+// discoverFilesCommand starts the file discovery process
+// fuzzyMatch reports whether every query character appears in target in
+// order, compared case-insensitively - enough for a quick-switcher without
+// a scoring dependency
+func fuzzyMatch(query, target string) bool {
+	query = strings.ToLower(query)
+	target = strings.ToLower(target)
+	pos := 0
+	for _, ch := range query {
+		rest := strings.IndexRune(target[pos:], ch)
+		if rest < 0 {
+			return false
 		}
+		pos += rest + 1
 	}
+	return true
+}
 
-	// PRIORITY 2: Standard Homebrew locations (fallback)
-	standardPaths := []string{
-		"/opt/homebrew/Cellar/slaygent-comms",      // macOS ARM
-		"/usr/local/Cellar/slaygent-comms",         // macOS Intel
-		"/home/linuxbrew/.linuxbrew/Cellar/slaygent-comms", // Linux
+// switcherMatches returns the indices of conversations whose participants
+// fuzzy-match the current switcher query
+func (m model) switcherMatches() []int {
+	if m.historyModel == nil {
+		return nil
 	}
-
-	for _, cellarBase := range standardPaths {
-		if entries, err := os.ReadDir(cellarBase); err == nil {
-			for _, entry := range entries {
-				if entry.IsDir() {
-					dynamicPath := filepath.Join(cellarBase, entry.Name(), "libexec", scriptName)
-					if _, err := os.Stat(dynamicPath); err == nil {
-						return dynamicPath
-					}
-				}
-			}
+	var matches []int
+	for i, conv := range m.historyModel.GetConversations() {
+		if fuzzyMatch(m.switcherQuery, conv.Agent1Name+" "+conv.Agent2Name) {
+			matches = append(matches, i)
 		}
 	}
+	return matches
+}
 
-	// PRIORITY 3: Development mode (relative path)
-	relativePath := "../scripts/" + scriptName
-	if _, err := os.Stat(relativePath); err == nil {
-		return relativePath
-	}
-
-	// PRIORITY 4: System install locations
-	systemPaths := []string{
-		"/opt/homebrew/lib/slaygent-comms/" + scriptName,
-		"/usr/local/lib/slaygent-comms/" + scriptName,
-		"/home/linuxbrew/.linuxbrew/lib/slaygent-comms/" + scriptName,
-		"/usr/lib/slaygent-comms/" + scriptName,
+// filteredRows returns the agents-table rows matching the current filter
+// query, compared case-insensitively against name, directory, agent type and
+// machine
+func (m model) filteredRows() [][]string {
+	if m.filterQuery == "" {
+		return m.rows
 	}
-
-	for _, path := range systemPaths {
-		if _, err := os.Stat(path); err == nil {
-			return path
+	query := strings.ToLower(m.filterQuery)
+	var matched [][]string
+	for _, row := range m.rows {
+		if len(row) < 6 {
+			continue
+		}
+		for _, cell := range []string{row[1], row[2], row[3], row[5]} {
+			if strings.Contains(strings.ToLower(cell), query) {
+				matched = append(matched, row)
+				break
+			}
 		}
 	}
-
-	// FALLBACK: Return path that will cause clear error
-	return "/usr/bin/false" // This will fail with clear error message
+	return matched
 }
 
-// getHomebrewPrefix returns the Homebrew prefix if brew is available
-func getHomebrewPrefix() string {
-	cmd := exec.Command("brew", "--prefix")
-	cmd.Env = os.Environ() // Ensure full environment is available
-	output, err := cmd.Output()
+// loadCompareSide loads one conversation's messages in the form the compare
+// view renders
+func (m model) loadCompareSide(conversationID int) ([]views.CompareMessage, error) {
+	messages, err := m.historyModel.MessagesFor(conversationID)
 	if err != nil {
-		return ""
+		return nil, err
 	}
-	return strings.TrimSpace(string(output))
+	side := make([]views.CompareMessage, 0, len(messages))
+	for _, msg := range messages {
+		side = append(side, views.CompareMessage{
+			Sender: msg.SenderName,
+			Body:   msg.Message,
+			SentAt: msg.SentAt,
+		})
+	}
+	return side, nil
 }
 
-// discoverFilesCommand starts the file discovery process
 func (m model) discoverFilesCommand() tea.Cmd {
 	return tea.Batch(
 		// Start the spinner animation
 		m.startFileDiscoverySpinner(),
 		// Start the actual file discovery
 		func() tea.Msg {
-			files, err := discoverFiles()
+			files, err := discoverFiles(m.pickerShowIgnored)
 			if err != nil {
 				return fileDiscoveryMsg{error: err.Error()}
 			}
 
+			// Offer to create files for registered projects without one
+			files = addMissingProjectFiles(files, m.registry)
+
 			// Auto-select current project files
 			files = selectCurrentProjectFiles(files)
 
@@ -420,6 +788,8 @@ func (m model) runCustomSyncOnSelectedFiles() tea.Cmd {
 			return syncCompleteMsg{filesUpdated: 0}
 		}
 
+		customContent = expandClauseTemplate(customContent)
+
 		filesUpdated := 0
 		for _, file := range selectedFiles {
 			if err := updateFileWithCustomContent(file.Path, customContent); err == nil {
@@ -449,7 +819,10 @@ func updateFileWithCustomContent(filePath, customContent string) error {
 	startMarker := "<!-- SLAYGENT-REGISTRY-START -->"
 	endMarker := "<!-- SLAYGENT-REGISTRY-END -->"
 
-	content := string(existingContent)
+	// Preserve the file's BOM and line ending convention
+	bom, content := splitBOM(string(existingContent))
+	eol := fileLineEnding(content)
+	customContent = adaptLineEndings(customContent, eol)
 
 	// Check if markers exist
 	startIdx := strings.Index(content, startMarker)
@@ -459,76 +832,47 @@ func updateFileWithCustomContent(filePath, customContent string) error {
 		// Replace existing content between markers
 		before := content[:startIdx]
 		after := content[endIdx+len(endMarker):]
-		newContent := before + startMarker + "\n" + customContent + "\n" + endMarker + after
-		return os.WriteFile(filePath, []byte(newContent), 0644)
+		newContent := before + startMarker + eol + customContent + eol + endMarker + after
+		return os.WriteFile(filePath, []byte(bom+newContent), 0644)
 	} else {
 		// Append new content with markers
-		newContent := content + "\n\n" + startMarker + "\n" + customContent + "\n" + endMarker + "\n"
-		return os.WriteFile(filePath, []byte(newContent), 0644)
+		newContent := content + eol + eol + startMarker + eol + customContent + eol + endMarker + eol
+		return os.WriteFile(filePath, []byte(bom+newContent), 0644)
 	}
 }
 
-// runSyncCommand executes the sync script
+// runSyncCommand syncs the default registry clause into every registered
+// project's CLAUDE.md
 func (m model) runSyncCommand() tea.Cmd {
 	return func() tea.Msg {
-		// Find and execute sync script
-		scriptPath := findSyncScript("sync-claude.sh")
-		cmd := exec.Command("bash", "-c", fmt.Sprintf("echo 'y' | %s", scriptPath))
-		cmd.Dir = os.Getenv("HOME")
-		output, err := cmd.Output()
+		filesUpdated, err := runRegistrySync()
 		if err != nil {
 			return syncCompleteMsg{filesUpdated: 0}
 		}
-
-		// Count how many files were updated by looking for "✓ Synced" in output
-		lines := strings.Split(string(output), "\n")
-		filesUpdated := 0
-		for _, line := range lines {
-			if strings.Contains(line, "✓ Synced") {
-				filesUpdated++
-			}
-		}
-
 		return syncCompleteMsg{filesUpdated: filesUpdated}
 	}
 }
 
-// runCustomSyncCommand executes the custom sync script with user's content
+// runCustomSyncCommand syncs the user's editor content into every registered
+// project's CLAUDE.md
 func (m model) runCustomSyncCommand() tea.Cmd {
 	return func() tea.Msg {
-		// Get the custom content from the editor
 		customContent := m.syncEditor.Value()
-
-		// Find custom sync script and create heredoc command
-		scriptPath := findSyncScript("custom-sync-claude.sh")
-		scriptCmd := fmt.Sprintf(`echo 'y' | %s "$(cat <<'EOF'
-%s
-EOF
-)"`, scriptPath, customContent)
-
-		// Execute custom sync script with the content via heredoc
-		cmd := exec.Command("bash", "-c", scriptCmd)
-		cmd.Dir = "."
-		output, err := cmd.Output()
-		if err != nil {
+		if strings.TrimSpace(customContent) == "" {
 			return syncCompleteMsg{filesUpdated: 0}
 		}
 
-		// Count how many files were updated by looking for "✓ Synced" in output
-		lines := strings.Split(string(output), "\n")
-		filesUpdated := 0
-		for _, line := range lines {
-			if strings.Contains(line, "✓ Synced") {
-				filesUpdated++
-			}
+		filesUpdated, err := syncRegistryClause(expandClauseTemplate(customContent))
+		if err != nil {
+			return syncCompleteMsg{filesUpdated: 0}
 		}
-
 		return syncCompleteMsg{filesUpdated: filesUpdated}
 	}
 }
 
-// runSyncProgressCommand executes sync for selected files with progress updates
-func (m model) runSyncProgressCommand(selectedFiles []DiscoveredFile) tea.Cmd {
+// runSyncProgressCommand executes sync for selected files with progress
+// updates; ctx cancels the remaining files
+func (m model) runSyncProgressCommand(ctx context.Context, selectedFiles []DiscoveredFile) tea.Cmd {
 	return func() tea.Msg {
 		customContent := m.syncEditor.Value()
 		if strings.TrimSpace(customContent) == "" {
@@ -536,14 +880,18 @@ func (m model) runSyncProgressCommand(selectedFiles []DiscoveredFile) tea.Cmd {
 		}
 
 		// We'll use a goroutine to send progress updates and then final completion
-		return m.executeProgressiveSync(selectedFiles, customContent)
+		return executeProgressiveSync(ctx, selectedFiles, expandClauseTemplate(customContent))
 	}
 }
 
-// executeProgressiveSync performs sync with real-time progress updates
-func (m model) executeProgressiveSync(selectedFiles []DiscoveredFile, customContent string) tea.Msg {
+// executeProgressiveSync performs sync with real-time progress updates,
+// stopping between files when ctx is cancelled so the completion report
+// only counts files actually written
+func executeProgressiveSync(ctx context.Context, selectedFiles []DiscoveredFile, customContent string) tea.Msg {
 	totalFiles := len(selectedFiles)
 	successCount := 0
+	skipped := 0
+	cancelled := false
 	var allLogs []string
 
 	// Send initial log
@@ -551,6 +899,13 @@ func (m model) executeProgressiveSync(selectedFiles []DiscoveredFile, customCont
 	allLogs = append(allLogs, initialMsg)
 
 	for i, file := range selectedFiles {
+		if ctx.Err() != nil {
+			cancelled = true
+			skipped = totalFiles - i
+			allLogs = append(allLogs, fmt.Sprintf("Sync cancelled - %d remaining files skipped", skipped))
+			break
+		}
+
 		// Create progress message
 		progressMsg := fmt.Sprintf("[%d/%d] Syncing %s...", i+1, totalFiles, makeDisplayPath(file.Path))
 		allLogs = append(allLogs, progressMsg)
@@ -573,6 +928,8 @@ func (m model) executeProgressiveSync(selectedFiles []DiscoveredFile, customCont
 	return syncProgressCompleteWithLogsMsg{
 		filesUpdated: successCount,
 		totalFiles:   totalFiles,
+		skipped:      skipped,
+		cancelled:    cancelled,
 		logs:         allLogs,
 	}
 }
@@ -591,41 +948,74 @@ type syncProgressErrorMsg struct {
 	error string
 }
 
+// syncEverywhereMsg carries the per-machine report from a bulk remote sync
+type syncEverywhereMsg struct {
+	report []string
+}
+
 type syncProgressCompleteWithLogsMsg struct {
 	filesUpdated int
 	totalFiles   int
+	skipped      int  // Files not attempted because the sync was cancelled
+	cancelled    bool
 	logs         []string
 }
 
-// writeFileContent writes custom content to the specified file
+// expandClauseTemplate substitutes the template variables supported in the
+// registry clause: {hostname} and {date}. The preview shows the same
+// expansion sync writes.
+func expandClauseTemplate(content string) string {
+	if hostname, err := os.Hostname(); err == nil {
+		content = strings.ReplaceAll(content, "{hostname}", hostname)
+	}
+	content = strings.ReplaceAll(content, "{date}", time.Now().Format("2006-01-02"))
+	if strings.Contains(content, "{roster}") {
+		content = strings.ReplaceAll(content, "{roster}", rosterSection())
+	}
+	return content
+}
+
+// rosterSection renders the live registry as a capability listing, so the
+// synced clause announces who exists and what they do instead of only how
+// to reach them. Regenerated from registry.json on every sync.
+func rosterSection() string {
+	registry, err := NewRegistry()
+	if err != nil {
+		return ""
+	}
+	agents := registry.GetAgents()
+	if len(agents) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("\n## Registered Agents\n")
+	for _, agent := range agents {
+		line := fmt.Sprintf("- `%s` (%s) in %s", agent.Name, agent.AgentType, agent.Directory)
+		if agent.Machine != "host" {
+			line += " on " + agent.Machine
+		}
+		if len(agent.Tags) > 0 {
+			line += " - " + strings.Join(agent.Tags, ", ")
+		}
+		b.WriteString(line + "\n")
+	}
+	return b.String()
+}
+
+// writeFileContent writes custom content to the specified file, creating
+// it with a project template header when it doesn't exist yet
 func writeFileContent(filePath, content string) error {
 	// Read existing file
 	existingBytes, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return os.WriteFile(filePath, []byte(newClauseFile(filePath, content)), 0644)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to read %s: %w", filePath, err)
 	}
 
-	existingContent := string(existingBytes)
-
-	// Find registry section markers
-	startMarker := "<!-- SLAYGENT-REGISTRY-START -->"
-	endMarker := "<!-- SLAYGENT-REGISTRY-END -->"
-
-	startIdx := strings.Index(existingContent, startMarker)
-	endIdx := strings.Index(existingContent, endMarker)
-
-	if startIdx == -1 || endIdx == -1 {
-		// No registry section found, append content
-		newContent := existingContent + "\n\n" + content + "\n"
-		return os.WriteFile(filePath, []byte(newContent), 0644)
-	}
-
-	// Replace content between markers
-	before := existingContent[:startIdx]
-	after := existingContent[endIdx+len(endMarker):]
-	newContent := before + startMarker + "\n" + content + "\n" + endMarker + after
-
-	return os.WriteFile(filePath, []byte(newContent), 0644)
+	return os.WriteFile(filePath, []byte(spliceRegistryClause(string(existingBytes), content)), 0644)
 }
 
 // makeDisplayPath converts absolute paths to user-friendly display paths
@@ -636,17 +1026,25 @@ func makeDisplayPath(absolutePath string) string {
 		return absolutePath // Fallback to absolute path if we can't get home
 	}
 
-	// Convert to relative path from home directory
+	// Convert to relative path from home directory (forward slashes even on
+	// Windows so "~/" display paths stay consistent)
 	if strings.HasPrefix(absolutePath, homeDir) {
 		relPath, err := filepath.Rel(homeDir, absolutePath)
 		if err == nil {
-			return "~/" + relPath
+			return "~/" + filepath.ToSlash(relPath)
 		}
 	}
 
 	return absolutePath // Fallback to absolute path
 }
 
+// liveTailTickCmd schedules the next live tail poll of messages.db
+func liveTailTickCmd() tea.Cmd {
+	return tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+		return liveTailMsg(t)
+	})
+}
+
 // syncTickCmd creates a tick for progress animation
 func syncTickCmd() tea.Cmd {
 	return tea.Tick(800*time.Millisecond, func(t time.Time) tea.Msg {
@@ -663,28 +1061,52 @@ func (m model) refreshAll() model {
 	}
 
 	// Get fresh tmux data from local and remote machines
+	takeSubprocessCount() // Attribute the spawns below to this refresh
 	rows, err := getTmuxPanesWithSSH(m.registry, m.sshRegistry)
+	m.lastRefreshSubprocs = takeSubprocessCount()
 	if err != nil {
-		m.rows = [][]string{
-			{"ERROR", "No tmux server", "unknown", "tmux-error", "error", "host", "✗"},
-			{"", "Run 'tmux new' to start", "", "", "", "", ""},
-		}
+		// Degraded mode: no local tmux server and no remote data. Keep the
+		// table empty rather than faking rows - the rest of the TUI works.
+		m.degradedMode = true
+		m.rows = appendPluginCells(nil, pluginColumns(m.plugins))
 	} else {
-		m.rows = rows
+		m.degradedMode = false
 		// No auto-adoption - remote agents are display-only and cannot be registered locally
-		// Sync registry to remove stale entries
+		// Auto-register configured directories, then remove stale entries
 		if m.registry != nil {
+			m.autoRegister(rows)
 			m.registry.SyncWithActive(rows)
 		}
+		if !m.showIgnored {
+			rows = filterIgnoredRows(rows, m.ignoreList)
+		}
+		m.rows = appendPluginCells(rows, pluginColumns(m.plugins))
 	}
 
-	// Rebuild table with bubble-table
-	m.table = views.BuildBubbleTable(m.rows, m.registry, m.width)
+	// Rebuild table with bubble-table; a refresh drops any applied filter
+	m.filterMode = false
+	m.filterQuery = ""
+	m.table = views.BuildBubbleTable(m.rows, m.registry, m.width, m.pluginColumnTitles(), m.tableOptions())
 	return m
 }
 
+// pluginColumnTitles returns the extra agents-table column titles contributed
+// by installed plugins
+func (m model) pluginColumnTitles() []string {
+	var titles []string
+	for _, p := range pluginColumns(m.plugins) {
+		titles = append(titles, p.Manifest.Column)
+	}
+	return titles
+}
+
 
 func main() {
+	// CLI subcommands bypass the full TUI
+	if runSlaySubcommand(os.Args[1:]) {
+		return
+	}
+
 	// Initialize registry
 	registry, err := NewRegistry()
 	if err != nil {
@@ -701,18 +1123,19 @@ func main() {
 		sshRegistry = nil
 	}
 
-	// Get tmux data from local and remote machines
-	rows, err := getTmuxPanesWithSSH(registry, sshRegistry)
-	if err != nil {
-		// Show error state with helpful message
-		rows = [][]string{
-			{"ERROR", "No tmux server running", "unknown", "tmux-error", "error", "host", "✗"},
-			{"HELP", "Run 'tmux new' to start", "", "", "", "", ""},
-		}
+	// Get tmux data from the local machine only - remote machines are
+	// queried in the background after the first frame, so cold start never
+	// waits on SSH round trips
+	rows, err := getTmuxPanesWithSSH(registry, nil)
+	degraded := err != nil
+	if degraded {
+		// No local tmux server and no remote data - start in degraded mode
+		// with an empty table instead of fake rows
+		rows = nil
 	}
 
 	// Handle empty result (no AI agents found)
-	if len(rows) == 0 {
+	if len(rows) == 0 && !degraded {
 		rows = [][]string{
 			{"INFO", "No AI agents detected", "unknown", "scan-result", "idle", "✗"},
 			{"HELP", "Start claude/opencode/coder/crush", "", "", "", ""},
@@ -723,33 +1146,40 @@ func main() {
 	prog := progress.New(progress.WithDefaultGradient())
 	prog.Width = 60
 
-	// Initialize history model
-	home, _ := os.UserHomeDir()
-	dbPath := filepath.Join(home, ".slaygent", "messages.db")
-	historyModel, err := history.New(dbPath)
-	if err != nil {
-		// Continue without history - Messages view will show "Database unavailable"
-		historyModel = nil
-	} else {
-		// Load initial conversations
-		historyModel.LoadConversations()
-	}
+	// messages.db is opened lazily on first use (ensureHistory) so cold
+	// start doesn't pay for it
 
 	// Initialize viewport for messages
 	vp := viewport.New(80, 20)
 
+	// Discover installed plugins and append their table cells
+	plugins := discoverPlugins()
+	ignoreList := loadIgnoreList()
+	rows = appendPluginCells(filterIgnoredRows(rows, ignoreList), pluginColumns(plugins))
+
+	config := loadTUIConfig()
+	views.LoadLocale(config.Locale)
+	if registry != nil {
+		registry.SetAutoGrace(config.autoGrace())
+	}
+
 	m := model{
 		rows:        rows,
+		plugins:     plugins,
+		config:      config,
+		keys:        newKeyMap(config.Keymap),
+		ignoreList:  ignoreList,
+		degradedMode: degraded,
 		registry:    registry,
 		sshRegistry: sshRegistry,
 		progress:    prog,
 		viewMode:    "agents",
-		historyModel: historyModel,
 		messagesViewport: vp,
 		width:       120,  // Default width, will be updated by WindowSizeMsg
 		height:      30,   // Default height, will be updated by WindowSizeMsg
 	}
-	m.table = views.BuildBubbleTable(m.rows, m.registry, m.width)
+	m.table = views.BuildBubbleTable(m.rows, m.registry, m.width, m.pluginColumnTitles(), m.tableOptions())
+	m = restoreSession(m)
 	defer func() {
 		if m.historyModel != nil {
 			m.historyModel.Close()
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"slaygent-manager/history"
+)
+
+// MCP server mode: `slay mcp` speaks the Model Context Protocol over
+// stdin/stdout (newline-delimited JSON-RPC), exposing send_message,
+// list_agents and get_conversation as tools backed by the same registry and
+// message database the TUI uses. Agents configured with it can message each
+// other as a tool call instead of shelling out to the msg binary.
+
+const mcpProtocolVersion = "2024-11-05"
+
+type mcpRequest struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+type mcpResponse struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *mcpError       `json:"error,omitempty"`
+}
+
+type mcpError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// mcpToolResult is the content block shape tools/call returns
+type mcpToolResult struct {
+	Content []mcpContent `json:"content"`
+	IsError bool         `json:"isError,omitempty"`
+}
+
+type mcpContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// mcpToolDefs describes the exposed tools for tools/list. Schemas are plain
+// JSON so they round-trip exactly as written.
+var mcpToolDefs = []map[string]interface{}{
+	{
+		"name":        "send_message",
+		"description": "Send a message to a registered agent. Set 'from' to your own agent name so the conversation is logged correctly.",
+		"inputSchema": json.RawMessage(`{"type":"object","properties":{"agent":{"type":"string","description":"Receiving agent name (or @group)"},"message":{"type":"string","description":"Message body"},"from":{"type":"string","description":"Sending agent name"}},"required":["agent","message"]}`),
+	},
+	{
+		"name":        "list_agents",
+		"description": "List all registered agents with their type, machine, and directory.",
+		"inputSchema": json.RawMessage(`{"type":"object","properties":{}}`),
+	},
+	{
+		"name":        "get_conversation",
+		"description": "Fetch the recent messages between two agents, oldest first.",
+		"inputSchema": json.RawMessage(`{"type":"object","properties":{"agent_a":{"type":"string"},"agent_b":{"type":"string"},"limit":{"type":"number","description":"Max messages to return (default 20)"}},"required":["agent_a","agent_b"]}`),
+	},
+}
+
+// runMCPCommand handles `slay mcp`, serving MCP requests until stdin closes
+func runMCPCommand(args []string) {
+	scanner := bufio.NewScanner(os.Stdin)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	out := json.NewEncoder(os.Stdout)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var req mcpRequest
+		if err := json.Unmarshal([]byte(line), &req); err != nil {
+			continue // Not a JSON-RPC message; nothing sensible to answer
+		}
+
+		resp := handleMCPRequest(req)
+		if resp == nil {
+			continue // Notification - no response expected
+		}
+		if err := out.Encode(resp); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// handleMCPRequest dispatches one request, returning nil for notifications
+func handleMCPRequest(req mcpRequest) *mcpResponse {
+	if req.ID == nil {
+		return nil // Notifications (e.g. notifications/initialized) get no reply
+	}
+
+	resp := &mcpResponse{Jsonrpc: "2.0", ID: req.ID}
+	switch req.Method {
+	case "initialize":
+		resp.Result = map[string]interface{}{
+			"protocolVersion": mcpProtocolVersion,
+			"capabilities":    map[string]interface{}{"tools": map[string]interface{}{}},
+			"serverInfo":      map[string]interface{}{"name": "slaygent-manager", "version": versionString()},
+		}
+	case "ping":
+		resp.Result = map[string]interface{}{}
+	case "tools/list":
+		resp.Result = map[string]interface{}{"tools": mcpToolDefs}
+	case "tools/call":
+		var params struct {
+			Name      string                 `json:"name"`
+			Arguments map[string]interface{} `json:"arguments"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			resp.Error = &mcpError{Code: -32602, Message: "invalid params"}
+			break
+		}
+		resp.Result = callMCPTool(params.Name, params.Arguments)
+	default:
+		resp.Error = &mcpError{Code: -32601, Message: fmt.Sprintf("method not found: %s", req.Method)}
+	}
+	return resp
+}
+
+// callMCPTool executes one tool; failures come back as isError results so
+// the calling agent sees what went wrong
+func callMCPTool(name string, args map[string]interface{}) mcpToolResult {
+	switch name {
+	case "send_message":
+		return mcpSendMessage(args)
+	case "list_agents":
+		return mcpListAgents()
+	case "get_conversation":
+		return mcpGetConversation(args)
+	}
+	return mcpToolError(fmt.Sprintf("unknown tool: %s", name))
+}
+
+func mcpToolError(text string) mcpToolResult {
+	return mcpToolResult{Content: []mcpContent{{Type: "text", Text: text}}, IsError: true}
+}
+
+func mcpToolText(text string) mcpToolResult {
+	return mcpToolResult{Content: []mcpContent{{Type: "text", Text: text}}}
+}
+
+// mcpStringArg reads a string argument, "" when absent or not a string
+func mcpStringArg(args map[string]interface{}, key string) string {
+	value, _ := args[key].(string)
+	return value
+}
+
+func mcpSendMessage(args map[string]interface{}) mcpToolResult {
+	agent := mcpStringArg(args, "agent")
+	message := mcpStringArg(args, "message")
+	if agent == "" || message == "" {
+		return mcpToolError("send_message needs agent and message")
+	}
+
+	// Delivery goes through the msg binary so every path (remote agents,
+	// groups, hooks, logging) behaves exactly as a shell send would
+	var cmd *exec.Cmd
+	if from := mcpStringArg(args, "from"); from != "" {
+		cmd = exec.Command("msg", "--from", from, agent, message)
+	} else {
+		cmd = exec.Command("msg", agent, message)
+	}
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return mcpToolError(fmt.Sprintf("send failed: %s", strings.TrimSpace(string(output))))
+	}
+	return mcpToolText(fmt.Sprintf("Message sent to %s", agent))
+}
+
+func mcpListAgents() mcpToolResult {
+	registry, err := NewRegistry()
+	if err != nil {
+		return mcpToolError(fmt.Sprintf("failed to load registry: %v", err))
+	}
+
+	agents := registry.GetAgents()
+	if len(agents) == 0 {
+		return mcpToolText("No agents registered")
+	}
+
+	var lines []string
+	for _, agent := range agents {
+		lines = append(lines, fmt.Sprintf("%s (%s) on %s in %s", agent.Name, agent.AgentType, agent.Machine, agent.Directory))
+	}
+	return mcpToolText(strings.Join(lines, "\n"))
+}
+
+func mcpGetConversation(args map[string]interface{}) mcpToolResult {
+	agentA := mcpStringArg(args, "agent_a")
+	agentB := mcpStringArg(args, "agent_b")
+	if agentA == "" || agentB == "" {
+		return mcpToolError("get_conversation needs agent_a and agent_b")
+	}
+
+	limit := 20
+	if n, ok := args["limit"].(float64); ok && n > 0 {
+		limit = int(n)
+	}
+
+	db, err := openEventsDB()
+	if err != nil {
+		return mcpToolError(fmt.Sprintf("failed to open message database: %v", err))
+	}
+	defer db.Close()
+
+	rows, err := db.Query(`
+		SELECT m.sender_name, m.receiver_name, m.message,
+		       datetime(m.sent_at, 'localtime'), COALESCE(m.compressed, 0)
+		FROM messages m
+		WHERE (m.sender_name = ? AND m.receiver_name = ?)
+		   OR (m.sender_name = ? AND m.receiver_name = ?)
+		ORDER BY m.sent_at DESC LIMIT ?`,
+		agentA, agentB, agentB, agentA, limit)
+	if err != nil {
+		return mcpToolError(fmt.Sprintf("query failed: %v", err))
+	}
+	defer rows.Close()
+
+	var lines []string
+	for rows.Next() {
+		var sender, receiver, body, sentAt string
+		var compressed int
+		if err := rows.Scan(&sender, &receiver, &body, &sentAt, &compressed); err != nil {
+			continue
+		}
+		body = history.DecompressBody(body, compressed)
+		lines = append(lines, fmt.Sprintf("[%s] %s → %s: %s", sentAt, sender, receiver, body))
+	}
+	if len(lines) == 0 {
+		return mcpToolText(fmt.Sprintf("No messages between %s and %s", agentA, agentB))
+	}
+
+	// Query returned newest first - present in conversation order
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+	return mcpToolText(strings.Join(lines, "\n"))
+}
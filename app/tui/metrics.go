@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"slaygent-manager/views"
+)
+
+// Per-agent traffic metrics ('M' in the agents view): message rates, average
+// reply latency, and delivery failure rate computed from messages.db over
+// the last 24 hours. Makes it easy to spot agents that are overloaded or
+// quietly ignoring requests.
+
+const metricsWindow = 24 * time.Hour
+
+// loadAgentMetrics aggregates the window's messages per agent, busiest first
+func loadAgentMetrics() ([]views.AgentMetrics, error) {
+	db, err := openEventsDB()
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	since := time.Now().UTC().Add(-metricsWindow).Format("2006-01-02 15:04:05")
+	rows, err := db.Query(`
+		SELECT sender_name, receiver_name, COALESCE(status, ''), datetime(sent_at)
+		FROM messages
+		WHERE sent_at >= ?
+		ORDER BY sent_at ASC`, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type tally struct {
+		sent, received, failed int
+		replyTotal             time.Duration
+		replyCount             int
+	}
+	tallies := make(map[string]*tally)
+	agentTally := func(name string) *tally {
+		if tallies[name] == nil {
+			tallies[name] = &tally{}
+		}
+		return tallies[name]
+	}
+
+	// Track the oldest unanswered message per sender→receiver pair; the
+	// next message flowing the other way counts as its reply
+	type pair struct{ from, to string }
+	awaiting := make(map[pair]time.Time)
+
+	for rows.Next() {
+		var sender, receiver, status, sentAt string
+		if err := rows.Scan(&sender, &receiver, &status, &sentAt); err != nil {
+			continue
+		}
+		at, err := time.Parse("2006-01-02 15:04:05", sentAt)
+		if err != nil {
+			continue
+		}
+
+		s := agentTally(sender)
+		s.sent++
+		if status == "failed" {
+			s.failed++
+		}
+		agentTally(receiver).received++
+
+		if asked, ok := awaiting[pair{receiver, sender}]; ok {
+			s.replyTotal += at.Sub(asked)
+			s.replyCount++
+			delete(awaiting, pair{receiver, sender})
+		}
+		if _, ok := awaiting[pair{sender, receiver}]; !ok {
+			awaiting[pair{sender, receiver}] = at
+		}
+	}
+
+	hours := metricsWindow.Hours()
+	var metrics []views.AgentMetrics
+	for name, t := range tallies {
+		m := views.AgentMetrics{
+			Name:       name,
+			Sent:       t.sent,
+			Received:   t.received,
+			OutPerHour: float64(t.sent) / hours,
+			InPerHour:  float64(t.received) / hours,
+			AvgReply:   "-",
+		}
+		if t.replyCount > 0 {
+			m.AvgReply = formatReplyLatency(t.replyTotal / time.Duration(t.replyCount))
+		}
+		if t.sent > 0 {
+			m.FailureRate = float64(t.failed) / float64(t.sent)
+		}
+		metrics = append(metrics, m)
+	}
+
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].Sent+metrics[i].Received > metrics[j].Sent+metrics[j].Received
+	})
+	return metrics, nil
+}
+
+// formatReplyLatency renders a latency compactly: 42s, 3.5m, 1.2h
+func formatReplyLatency(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%.0fs", d.Seconds())
+	case d < time.Hour:
+		return fmt.Sprintf("%.1fm", d.Minutes())
+	default:
+		return fmt.Sprintf("%.1fh", d.Hours())
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Agent name suggestions from the name_template config key. Templates use
+// {dirbase} (last segment of the working directory), {type} (agent type)
+// and {machine}; "{dirbase}-{type}" names a claude agent in ~/code/api
+// "api-claude". With a template configured the register prompt is
+// prefilled and Shift+A registers every unregistered local agent at once.
+
+// suggestAgentName expands the template for one agent, appending -2/-3/...
+// while taken reports the candidate as already in use
+func suggestAgentName(template, agentType, directory, machine string, taken func(string) bool) string {
+	if template == "" {
+		return ""
+	}
+	name := template
+	name = strings.ReplaceAll(name, "{dirbase}", filepath.Base(directory))
+	name = strings.ReplaceAll(name, "{type}", agentType)
+	name = strings.ReplaceAll(name, "{machine}", machine)
+	name = sanitizeAgentName(name)
+	if name == "" || taken == nil || !taken(name) {
+		return name
+	}
+	for i := 2; i < 100; i++ {
+		candidate := fmt.Sprintf("%s-%d", name, i)
+		if !taken(candidate) {
+			return candidate
+		}
+	}
+	return name
+}
+
+// sanitizeAgentName lowercases and reduces to [a-z0-9-], collapsing runs of
+// other characters to single hyphens
+func sanitizeAgentName(name string) string {
+	var b strings.Builder
+	lastHyphen := true // Also trims leading hyphens
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			lastHyphen = false
+		default:
+			if !lastHyphen {
+				b.WriteRune('-')
+				lastHyphen = true
+			}
+		}
+	}
+	return strings.TrimRight(b.String(), "-")
+}
+
+// nameTaken reports whether a registry entry already uses name
+func (m model) nameTaken(name string) bool {
+	if m.registry == nil {
+		return false
+	}
+	for _, agent := range m.registry.GetAgents() {
+		if agent.Name == name {
+			return true
+		}
+	}
+	return false
+}
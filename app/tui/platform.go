@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// isWSL reports whether we're running inside Windows Subsystem for Linux
+// (tmux in WSL driven from Windows Terminal). Unix tooling like ps and fd
+// works normally there, but detection lets callers adjust path display.
+func isWSL() bool {
+	if runtime.GOOS != "linux" {
+		return false
+	}
+	data, err := os.ReadFile("/proc/version")
+	if err != nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(string(data)), "microsoft")
+}
+
+// windowsProcessSnapshot fills a snapshot using wmic since ps isn't available
+// on native Windows. Output is CSV: Node,CommandLine,ParentProcessId,ProcessId.
+func windowsProcessSnapshot(snapshot *processSnapshot) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "wmic", "process", "get",
+		"CommandLine,ParentProcessId,ProcessId", "/format:csv")
+	output, err := cmd.Output()
+	if err != nil {
+		return
+	}
+
+	for _, line := range strings.Split(string(output), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "Node,") {
+			continue
+		}
+
+		// CommandLine may itself contain commas, so split from the right:
+		// the last two fields are ParentProcessId and ProcessId
+		fields := strings.Split(line, ",")
+		if len(fields) < 4 {
+			continue
+		}
+		pid := strings.TrimSpace(fields[len(fields)-1])
+		ppid := strings.TrimSpace(fields[len(fields)-2])
+		command := strings.Join(fields[1:len(fields)-2], ",")
+		if pid == "" {
+			continue
+		}
+
+		snapshot.commands[pid] = command
+		snapshot.children[ppid] = append(snapshot.children[ppid], pid)
+	}
+}
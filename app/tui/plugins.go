@@ -0,0 +1,159 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Plugin system: executables dropped into ~/.slaygent/plugins extend slaygent
+// without forking. A plugin speaks a small JSON protocol over argv/stdout:
+//
+//	<plugin> describe                          -> manifest JSON (below)
+//	<plugin> view                              -> text rendered in the TUI Plugins view
+//	<plugin> cell <pane> <dir> <type> <name>   -> one table cell for the plugin's column
+//	<plugin> run [args...]                     -> executed for the plugin's msg subcommands
+//
+// Manifest example:
+//
+//	{"name": "stats", "description": "...", "view": true,
+//	 "column": "TICKETS", "commands": ["stats"]}
+
+// PluginManifest is the JSON a plugin prints for `describe`
+type PluginManifest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	View        bool     `json:"view"`               // Provides a TUI view via `view`
+	Column      string   `json:"column,omitempty"`   // Agents-table column title served via `cell`
+	Commands    []string `json:"commands,omitempty"` // msg subcommand names served via `run`
+}
+
+// Plugin is a discovered plugin executable with its manifest
+type Plugin struct {
+	Path     string
+	Manifest PluginManifest
+}
+
+// pluginsDir returns the plugin directory under ~/.slaygent
+func pluginsDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".slaygent", "plugins")
+}
+
+// discoverPlugins finds executables in the plugin directory and loads their
+// manifests. Plugins that fail to describe themselves are skipped.
+func discoverPlugins() []Plugin {
+	dir := pluginsDir()
+	if dir == "" {
+		return nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil // No plugin directory - nothing installed
+	}
+
+	var plugins []Plugin
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.Mode()&0111 == 0 {
+			continue // Not executable
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		output, err := exec.Command(path, "describe").Output()
+		if err != nil {
+			continue
+		}
+
+		var manifest PluginManifest
+		if err := json.Unmarshal(output, &manifest); err != nil || manifest.Name == "" {
+			continue
+		}
+		plugins = append(plugins, Plugin{Path: path, Manifest: manifest})
+	}
+	return plugins
+}
+
+// pluginView runs a plugin's `view` command and returns its output
+func pluginView(p Plugin) string {
+	output, err := exec.Command(p.Path, "view").Output()
+	if err != nil {
+		return fmt.Sprintf("(%s view failed: %v)", p.Manifest.Name, err)
+	}
+	return strings.TrimRight(string(output), "\n")
+}
+
+// pluginColumns returns plugins that contribute an agents-table column
+func pluginColumns(plugins []Plugin) []Plugin {
+	var cols []Plugin
+	for _, p := range plugins {
+		if p.Manifest.Column != "" {
+			cols = append(cols, p)
+		}
+	}
+	return cols
+}
+
+// pluginCell asks a column plugin for one table cell
+func pluginCell(p Plugin, row []string) string {
+	// pane, directory, agent type, name
+	output, err := exec.Command(p.Path, "cell", row[0], row[1], row[2], row[3]).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// appendPluginCells extends agent rows with one cell per column plugin
+func appendPluginCells(rows [][]string, columns []Plugin) [][]string {
+	if len(columns) == 0 {
+		return rows
+	}
+	for i, row := range rows {
+		if len(row) < 4 {
+			continue
+		}
+		for _, p := range columns {
+			rows[i] = append(rows[i], pluginCell(p, row))
+		}
+	}
+	return rows
+}
+
+// runPluginCommand handles `slay plugin <subcommand>`
+func runPluginCommand(args []string) {
+	if len(args) < 1 || args[0] != "list" {
+		fmt.Fprintf(os.Stderr, "Usage: slay plugin list\n")
+		os.Exit(1)
+	}
+
+	plugins := discoverPlugins()
+	if len(plugins) == 0 {
+		fmt.Printf("No plugins installed in %s\n", pluginsDir())
+		return
+	}
+
+	for _, p := range plugins {
+		features := []string{}
+		if p.Manifest.View {
+			features = append(features, "view")
+		}
+		if p.Manifest.Column != "" {
+			features = append(features, "column:"+p.Manifest.Column)
+		}
+		for _, cmd := range p.Manifest.Commands {
+			features = append(features, "msg --"+cmd)
+		}
+		fmt.Printf("%-16s %s (%s)\n", p.Manifest.Name, p.Manifest.Description, strings.Join(features, ", "))
+	}
+}
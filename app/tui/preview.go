@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Pane preview ('P' in the agents view): captures the selected agent's pane
+// content so a row can be eyeballed before sending work its way. Local panes
+// use tmux capture-pane directly; remote rows run capture-pane over SSH on
+// the remote machine's tmux, locating the pane by its working directory.
+
+// panePreviewMsg carries captured pane content back to the Update loop
+type panePreviewMsg struct {
+	title   string
+	content string
+}
+
+// capturePaneCmd captures the pane behind one agents-table row asynchronously
+func capturePaneCmd(row []string, sshRegistry *SSHRegistry) tea.Cmd {
+	paneID := row[0]
+	directory := row[1]
+	name := row[3]
+	machine := row[5]
+	title := fmt.Sprintf("%s on %s", name, machine)
+
+	return func() tea.Msg {
+		if isRegistrableMachine(machine) {
+			return panePreviewMsg{title: title, content: captureLocalPane(paneID)}
+		}
+		if sshRegistry == nil {
+			return panePreviewMsg{title: title, content: "SSH registry unavailable"}
+		}
+		conn := sshRegistry.GetConnection(machine)
+		if conn == nil {
+			return panePreviewMsg{title: title, content: "No SSH connection named " + machine}
+		}
+		return panePreviewMsg{title: title, content: captureRemotePane(*conn, directory)}
+	}
+}
+
+// captureLocalPane returns the last screenful of a local tmux pane
+func captureLocalPane(paneID string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, "tmux", "capture-pane", "-p", "-t", paneID, "-S", "-100").Output()
+	if err != nil {
+		return fmt.Sprintf("capture-pane failed for %s: %v", paneID, err)
+	}
+	return strings.TrimRight(string(output), "\n")
+}
+
+// captureRemotePane runs capture-pane on the remote tmux over SSH, matching
+// the pane by working directory since remote rows carry no pane ID
+func captureRemotePane(conn SSHConnection, directory string) string {
+	sshParts := strings.Fields(conn.ConnectCommand)
+	if len(sshParts) == 0 {
+		return "SSH connect command is empty"
+	}
+	if conn.SSHKey != "" {
+		expandedKey := expandSSHKey(conn.SSHKey)
+		sshParts = append(sshParts[:1], append([]string{"-i", expandedKey}, sshParts[1:]...)...)
+	}
+
+	// Single-quote the directory for the remote shell
+	quoted := "'" + strings.ReplaceAll(directory, "'", `'\''`) + "'"
+	remoteCmd := fmt.Sprintf(
+		`p=$(tmux list-panes -a -F '#{pane_id} #{pane_current_path}' 2>/dev/null | awk -v d=%s '$2 == d {print $1; exit}'); `+
+			`if [ -n "$p" ]; then tmux capture-pane -p -t "$p" -S -100; else echo "no tmux pane found in "%s; fi`,
+		quoted, quoted)
+	fullCmd := append(sshParts, remoteCmd)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, fullCmd[0], fullCmd[1:]...).Output()
+	if err != nil {
+		return fmt.Sprintf("remote capture on %s failed: %v", conn.Name, err)
+	}
+	return strings.TrimRight(string(output), "\n")
+}
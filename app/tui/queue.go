@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"slaygent-manager/views"
+)
+
+// Queue view plumbing: the 'Q' key lists messages whose delivery failed or
+// landed in a detached session, so stuck deliveries can be retried, edited,
+// or dropped without leaving the TUI.
+
+// loadQueue refreshes the model's queue items from the message database
+func (m model) loadQueue() model {
+	m.queueItems = nil
+	if m.historyModel == nil {
+		return m
+	}
+	queued, err := m.historyModel.QueuedMessages()
+	if err != nil {
+		return m
+	}
+	for _, msg := range queued {
+		m.queueItems = append(m.queueItems, views.QueueItem{
+			ID:       msg.ID,
+			Sender:   msg.SenderName,
+			Receiver: msg.ReceiverName,
+			Body:     msg.Message,
+			Time:     msg.SentAt.Local().Format("Jan 2 15:04"),
+			Status:   msg.Status,
+		})
+	}
+	if m.queueIndex >= len(m.queueItems) {
+		m.queueIndex = len(m.queueItems) - 1
+	}
+	if m.queueIndex < 0 {
+		m.queueIndex = 0
+	}
+	return m
+}
+
+// retryQueuedMessage re-sends a stuck delivery through msg. On success the
+// stale row is dropped - msg logs the resend as a fresh message.
+func (m model) retryQueuedMessage(item views.QueueItem) error {
+	body := item.Body
+	if m.historyModel != nil {
+		// Re-send the current stored body in case it was edited
+		if queued, err := m.historyModel.QueuedMessages(); err == nil {
+			for _, msg := range queued {
+				if msg.ID == item.ID {
+					body = msg.Message
+					break
+				}
+			}
+		}
+	}
+
+	var cmd *exec.Cmd
+	if item.Sender != "" && item.Sender != "human" {
+		cmd = exec.Command("msg", "--from", item.Sender, item.Receiver, body)
+	} else {
+		cmd = exec.Command("msg", "--as-human", item.Receiver, body)
+	}
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s", strings.TrimSpace(string(output)))
+	}
+
+	if m.historyModel != nil {
+		m.historyModel.DeleteMessage(item.ID)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"slaygent-manager/views"
+)
+
+// Async refresh: each source (local scan, every SSH machine) runs as its own
+// Bubble Tea command and lands independently, so one slow remote doesn't
+// block the rest of the table from updating.
+
+// refreshSourceMsg carries freshly scanned rows from one source
+type refreshSourceMsg struct {
+	source   string
+	rows     [][]string
+	degraded bool // Local scan found no tmux server
+}
+
+// startRefresh kicks off one command per source and marks them all pending
+func (m model) startRefresh() (model, tea.Cmd) {
+	m.refreshPending = map[string]bool{"local": true}
+	if m.sourceRows == nil {
+		m.sourceRows = make(map[string][][]string)
+	}
+
+	// Initialize spinner
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("62"))
+	m.refreshSpinner = s
+
+	cmds := []tea.Cmd{refreshLocalCmd(m.registry), m.refreshSpinner.Tick}
+	if m.sshRegistry != nil {
+		for _, conn := range m.sshRegistry.GetConnections() {
+			conn := conn
+			m.refreshPending["ssh:"+conn.Name] = true
+			cmds = append(cmds, func() tea.Msg {
+				return refreshSourceMsg{source: "ssh:" + conn.Name, rows: remoteRegistryRows(conn)}
+			})
+		}
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// refreshLocalCmd scans local tmux, docker, WezTerm, and kitty
+func refreshLocalCmd(registry *Registry) tea.Cmd {
+	return func() tea.Msg {
+		rows, err := getLocalRows(registry)
+		return refreshSourceMsg{source: "local", rows: rows, degraded: err != nil}
+	}
+}
+
+// applyRefreshSource merges one source's rows and rebuilds the table,
+// rendering partial results while slower sources are still in flight
+func (m model) applyRefreshSource(msg refreshSourceMsg) model {
+	if m.sourceRows == nil {
+		m.sourceRows = make(map[string][][]string)
+	}
+	m.sourceRows[msg.source] = msg.rows
+	delete(m.refreshPending, msg.source)
+
+	// Once the last in-flight source lands, attribute the spawns to this
+	// refresh for the audit overlay
+	if len(m.refreshPending) == 0 {
+		m.lastRefreshSubprocs = takeSubprocessCount()
+	}
+
+	// Local results drive auto-registration and registry cleanup, same as
+	// the synchronous path. A scan without a tmux server flips degraded
+	// mode instead of faking error rows.
+	if msg.source == "local" {
+		m.degradedMode = msg.degraded
+		if m.registry != nil && len(msg.rows) > 0 {
+			m.autoRegister(msg.rows)
+			m.registry.SyncWithActive(msg.rows)
+		}
+	}
+
+	// Rebuild rows: local first, then remotes in stable name order
+	rows := append([][]string{}, m.sourceRows["local"]...)
+	var remotes []string
+	for source := range m.sourceRows {
+		if source != "local" {
+			remotes = append(remotes, source)
+		}
+	}
+	sort.Strings(remotes)
+	for _, source := range remotes {
+		rows = append(rows, m.sourceRows[source]...)
+	}
+
+	if !m.showIgnored {
+		rows = filterIgnoredRows(rows, m.ignoreList)
+	}
+	m.rows = appendPluginCells(rows, pluginColumns(m.plugins))
+	m.table = views.BuildBubbleTable(m.rows, m.registry, m.width, m.pluginColumnTitles(), m.tableOptions())
+	return m
+}
+
+// refreshStatusLine lists the sources still being scanned, or "" when idle
+func (m model) refreshStatusLine() string {
+	if len(m.refreshPending) == 0 {
+		return ""
+	}
+
+	var pending []string
+	for source := range m.refreshPending {
+		pending = append(pending, source)
+	}
+	sort.Strings(pending)
+	return m.refreshSpinner.View() + " Refreshing: " + strings.Join(pending, ", ")
+}
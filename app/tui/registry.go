@@ -2,8 +2,11 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 )
 
 // RegisteredAgent is a simple registration with name, type, and directory
@@ -12,12 +15,38 @@ type RegisteredAgent struct {
 	AgentType string `json:"agent_type"` // claude, opencode, coder, crush
 	Directory string `json:"directory"`  // Full working directory path
 	Machine   string `json:"machine"`    // Machine name (defaults to "host")
+	Delivery  string `json:"delivery,omitempty"` // Optional msg delivery mode ("inbox" or "typewriter")
+	Tags      []string `json:"tags,omitempty"`   // Optional labels shown in the TAGS column
+	Groups    []string `json:"groups,omitempty"` // Group names for `msg @group` fan-out sends
+	Color     string `json:"color,omitempty"`    // Optional hex color shown for this agent in the TUI
+	DND       bool   `json:"dnd,omitempty"`      // Do-not-disturb: messages queue instead of injecting
+	DNDSchedule string `json:"dnd_schedule,omitempty"` // Recurring quiet hours, "22:00-07:00" local time
+	Pane      string `json:"pane,omitempty"`     // Optional pane binding so same-directory agents stay distinct
+	Auto      bool   `json:"auto,omitempty"`     // Registered by auto-registration, removed after the grace period
+}
+
+// isRegistrableMachine reports whether agents on this machine can be
+// registered locally: the host itself or a local docker container. Remote SSH
+// agents keep their registration on their home machine.
+func isRegistrableMachine(machine string) bool {
+	return machine == "host" || strings.HasPrefix(machine, "docker:")
 }
 
 // Registry manages the registry.json file
 type Registry struct {
 	agents   []RegisteredAgent
 	filePath string
+
+	// Auto-registration bookkeeping: when each missing auto entry was
+	// first seen gone, and how long it may stay gone before removal
+	autoGrace   time.Duration
+	autoMissing map[string]time.Time
+}
+
+// SetAutoGrace sets how long auto-registered agents survive after their
+// pane disappears before SyncWithActive drops them
+func (r *Registry) SetAutoGrace(grace time.Duration) {
+	r.autoGrace = grace
 }
 
 // NewRegistry creates or loads the registry
@@ -47,10 +76,18 @@ func (r *Registry) Register(name, agentType, directory string) error {
 	return r.RegisterWithMachine(name, agentType, directory, "host")
 }
 
-// RegisterWithMachine adds a new agent with a name and machine
+// RegisterWithMachine adds a new agent with a name and machine, with no
+// pane binding
 func (r *Registry) RegisterWithMachine(name, agentType, directory, machine string) error {
-	// Remove any existing registration for this type+directory+machine
-	r.DeregisterWithMachine(agentType, directory, machine)
+	return r.RegisterWithPane(name, agentType, directory, machine, "")
+}
+
+// RegisterWithPane adds a new agent, optionally bound to a pane ID so two
+// agents of the same type in the same directory can be registered separately
+func (r *Registry) RegisterWithPane(name, agentType, directory, machine, pane string) error {
+	// Replace only the registration for the same key (including pane) -
+	// pane-bound siblings in the same directory stay untouched
+	r.DeregisterWithPane(agentType, directory, machine, pane)
 
 	// Add new registration
 	r.agents = append(r.agents, RegisteredAgent{
@@ -58,8 +95,29 @@ func (r *Registry) RegisterWithMachine(name, agentType, directory, machine strin
 		AgentType: agentType,
 		Directory: directory,
 		Machine:   machine,
+		Pane:      pane,
+	})
+
+	logEvent("register", fmt.Sprintf("%s (%s) in %s on %s", name, agentType, directory, machine))
+	return r.Save()
+}
+
+// RegisterAuto records an auto-registered agent. These behave like normal
+// registrations except SyncWithActive keeps them for the grace period after
+// their agent disappears.
+func (r *Registry) RegisterAuto(name, agentType, directory, machine, pane string) error {
+	r.DeregisterWithPane(agentType, directory, machine, pane)
+
+	r.agents = append(r.agents, RegisteredAgent{
+		Name:      name,
+		AgentType: agentType,
+		Directory: directory,
+		Machine:   machine,
+		Pane:      pane,
+		Auto:      true,
 	})
 
+	logEvent("auto-register", fmt.Sprintf("%s (%s) in %s on %s", name, agentType, directory, machine))
 	return r.Save()
 }
 
@@ -70,13 +128,33 @@ func (r *Registry) Deregister(agentType, directory string) error {
 
 // DeregisterWithMachine removes an agent by type, directory, and machine
 func (r *Registry) DeregisterWithMachine(agentType, directory, machine string) error {
-	filtered := []RegisteredAgent{}
-	for _, agent := range r.agents {
-		if !(agent.AgentType == agentType && agent.Directory == directory && agent.Machine == machine) {
-			filtered = append(filtered, agent)
+	return r.DeregisterWithPane(agentType, directory, machine, "")
+}
+
+// DeregisterWithPane removes the registration bound to the given pane.
+// When no exact pane match exists, it falls back to the unbound entry for
+// the same type+directory+machine so pre-pane registrations still toggle.
+func (r *Registry) DeregisterWithPane(agentType, directory, machine, pane string) error {
+	removeIdx := -1
+	for i, agent := range r.agents {
+		if agent.AgentType != agentType || agent.Directory != directory || agent.Machine != machine {
+			continue
+		}
+		if agent.Pane == pane {
+			removeIdx = i
+			break
 		}
+		if agent.Pane == "" && removeIdx == -1 {
+			removeIdx = i // Legacy unbound entry, used unless an exact match appears
+		}
+	}
+	if removeIdx == -1 {
+		return nil
 	}
-	r.agents = filtered
+
+	agent := r.agents[removeIdx]
+	logEvent("deregister", fmt.Sprintf("%s (%s) in %s on %s", agent.Name, agentType, directory, machine))
+	r.agents = append(r.agents[:removeIdx], r.agents[removeIdx+1:]...)
 	return r.Save()
 }
 
@@ -110,11 +188,149 @@ func (r *Registry) GetNameWithMachine(agentType, directory, machine string) stri
 	return ""
 }
 
+// GetNameForPane resolves the name for a specific pane: an exact pane
+// binding wins, otherwise the unbound entry for the same key applies
+func (r *Registry) GetNameForPane(agentType, directory, machine, pane string) string {
+	fallback := ""
+	for _, agent := range r.agents {
+		if agent.AgentType != agentType || agent.Directory != directory || agent.Machine != machine {
+			continue
+		}
+		if agent.Pane == pane {
+			return agent.Name
+		}
+		if agent.Pane == "" {
+			fallback = agent.Name
+		}
+	}
+	return fallback
+}
+
 // GetAgents returns all registered agents
 func (r *Registry) GetAgents() []RegisteredAgent {
 	return r.agents
 }
 
+// GetGroups returns the group memberships for a registered name
+func (r *Registry) GetGroups(name string) []string {
+	for _, agent := range r.agents {
+		if agent.Name == name {
+			return agent.Groups
+		}
+	}
+	return nil
+}
+
+// SetGroups replaces the group memberships for a registered name
+func (r *Registry) SetGroups(name string, groups []string) error {
+	for i := range r.agents {
+		if r.agents[i].Name == name {
+			r.agents[i].Groups = groups
+			logEvent("groups", fmt.Sprintf("%s -> [%s]", name, strings.Join(groups, ", ")))
+			return r.Save()
+		}
+	}
+	return fmt.Errorf("agent '%s' not found in registry", name)
+}
+
+// GetColor returns the custom display color for a registered name, "" when
+// the agent keeps its type-based color
+func (r *Registry) GetColor(name string) string {
+	for _, agent := range r.agents {
+		if agent.Name == name {
+			return agent.Color
+		}
+	}
+	return ""
+}
+
+// SetColor assigns (or with "" clears) the custom display color for a
+// registered name
+func (r *Registry) SetColor(name, color string) error {
+	for i := range r.agents {
+		if r.agents[i].Name == name {
+			r.agents[i].Color = color
+			return r.Save()
+		}
+	}
+	return fmt.Errorf("agent '%s' not found in registry", name)
+}
+
+// isHexColor reports whether s is a "#RRGGBB" hex color
+func isHexColor(s string) bool {
+	if len(s) != 7 || s[0] != '#' {
+		return false
+	}
+	for _, c := range s[1:] {
+		switch {
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'f', c >= 'A' && c <= 'F':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// ToggleDND flips the manual do-not-disturb flag for a registered name,
+// returning the new state
+func (r *Registry) ToggleDND(name string) (bool, error) {
+	for i := range r.agents {
+		if r.agents[i].Name == name {
+			r.agents[i].DND = !r.agents[i].DND
+			logEvent("dnd", fmt.Sprintf("%s -> %v", name, r.agents[i].DND))
+			return r.agents[i].DND, r.Save()
+		}
+	}
+	return false, fmt.Errorf("agent '%s' not found in registry", name)
+}
+
+// DNDAgents returns registered name -> true for every agent currently in
+// do-not-disturb, whether flagged manually or inside their quiet hours
+func (r *Registry) DNDAgents() map[string]bool {
+	dnd := make(map[string]bool)
+	now := time.Now()
+	for _, agent := range r.agents {
+		if agent.DND || quietHoursActive(agent.DNDSchedule, now) {
+			dnd[agent.Name] = true
+		}
+	}
+	return dnd
+}
+
+// quietHoursActive reports whether now falls inside a "HH:MM-HH:MM" local
+// schedule; overnight windows like 22:00-07:00 wrap past midnight
+func quietHoursActive(schedule string, now time.Time) bool {
+	parts := strings.Split(schedule, "-")
+	if len(parts) != 2 {
+		return false
+	}
+	start, err1 := time.Parse("15:04", strings.TrimSpace(parts[0]))
+	end, err2 := time.Parse("15:04", strings.TrimSpace(parts[1]))
+	if err1 != nil || err2 != nil {
+		return false
+	}
+
+	minutes := now.Hour()*60 + now.Minute()
+	startMin := start.Hour()*60 + start.Minute()
+	endMin := end.Hour()*60 + end.Minute()
+	if startMin <= endMin {
+		return minutes >= startMin && minutes < endMin
+	}
+	return minutes >= startMin || minutes < endMin
+}
+
+// ColorOverrides returns registered name -> custom color for every agent
+// that has one
+func (r *Registry) ColorOverrides() map[string]string {
+	overrides := make(map[string]string)
+	for _, agent := range r.agents {
+		if agent.Color != "" {
+			overrides[agent.Name] = agent.Color
+		}
+	}
+	return overrides
+}
+
 // Load reads the registry from disk
 func (r *Registry) Load() error {
 	data, err := os.ReadFile(r.filePath)
@@ -148,22 +364,33 @@ func (r *Registry) Load() error {
 
 // SyncWithActive removes registry entries that don't match any active agents
 func (r *Registry) SyncWithActive(activeAgents [][]string) error {
-	// Build set of active agent keys (type:directory)
+	// Build sets of active agent keys (type:directory, plus pane-qualified)
 	activeSet := make(map[string]bool)
+	activePanes := make(map[string]bool)
 	for _, row := range activeAgents {
 		if len(row) >= 3 {
 			agentType := row[2]  // AGENT column
 			directory := row[1]  // DIRECTORY column
 			key := agentType + ":" + directory
 			activeSet[key] = true
+			activePanes[key+":"+row[0]] = true // PANE column
 		}
 	}
 
-	// Filter out agents that are no longer active
+	// Filter out agents that are no longer active. Pane-bound entries must
+	// match their exact pane, unbound entries just need the directory alive.
+	// Auto-registered entries get the grace period before removal.
 	filtered := []RegisteredAgent{}
 	for _, agent := range r.agents {
 		key := agent.AgentType + ":" + agent.Directory
-		if activeSet[key] {
+		alive := activeSet[key]
+		if agent.Pane != "" {
+			alive = activePanes[key+":"+agent.Pane]
+		}
+		if agent.Auto {
+			alive = r.autoAlive(agent, alive)
+		}
+		if alive {
 			filtered = append(filtered, agent)
 		}
 	}
@@ -176,6 +403,30 @@ func (r *Registry) SyncWithActive(activeAgents [][]string) error {
 	return nil
 }
 
+// autoAlive applies the grace period to one auto-registered entry: a missing
+// agent stays registered until it has been gone longer than the grace
+func (r *Registry) autoAlive(agent RegisteredAgent, active bool) bool {
+	if r.autoMissing == nil {
+		r.autoMissing = make(map[string]time.Time)
+	}
+	key := agent.AgentType + ":" + agent.Directory + ":" + agent.Machine + ":" + agent.Pane
+
+	if active {
+		delete(r.autoMissing, key)
+		return true
+	}
+	since, seen := r.autoMissing[key]
+	if !seen {
+		r.autoMissing[key] = time.Now()
+		return true
+	}
+	if time.Since(since) < r.autoGrace {
+		return true
+	}
+	delete(r.autoMissing, key)
+	return false
+}
+
 // Save writes the registry to disk
 func (r *Registry) Save() error {
 	data, err := json.MarshalIndent(r.agents, "", "  ")
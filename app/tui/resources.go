@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Remote machine resource readouts for the SSH connections view: one cheap
+// command per machine gathers load average, memory, and disk so heavy tasks
+// can be pointed at the machine with headroom. Fetched in the background
+// when the view opens, same async pattern as the table refresh.
+
+// machineResourcesMsg carries one machine's readout back to the Update loop
+type machineResourcesMsg struct {
+	name    string
+	readout string
+}
+
+// fetchMachineResourcesCmds returns one background fetch per SSH connection
+func fetchMachineResourcesCmds(sshRegistry *SSHRegistry) []tea.Cmd {
+	if sshRegistry == nil {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	for _, conn := range sshRegistry.GetConnections() {
+		conn := conn
+		cmds = append(cmds, func() tea.Msg {
+			return machineResourcesMsg{name: conn.Name, readout: fetchMachineResources(conn)}
+		})
+	}
+	return cmds
+}
+
+// fetchMachineResources runs the readout command on one machine over SSH
+func fetchMachineResources(conn SSHConnection) string {
+	sshParts := strings.Fields(conn.ConnectCommand)
+	if len(sshParts) == 0 {
+		return "unreachable"
+	}
+	if conn.SSHKey != "" {
+		expandedKey := expandSSHKey(conn.SSHKey)
+		sshParts = append(sshParts[:1], append([]string{"-i", expandedKey}, sshParts[1:]...)...)
+	}
+
+	// POSIX-friendly one-liner: uptime for load, free for memory (absent on
+	// macOS, falls back to n/a), df for the home filesystem
+	remoteCmd := `load=$(uptime | sed 's/.*load average[s]*: *//'); ` +
+		`mem=$(free -m 2>/dev/null | awk 'NR==2{printf "%d/%dMB", $3, $2}'); ` +
+		`disk=$(df -h "$HOME" 2>/dev/null | awk 'NR==2{print $5}'); ` +
+		`echo "load $load | mem ${mem:-n/a} | disk ${disk:-n/a} used"`
+	fullCmd := append(sshParts, remoteCmd)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, fullCmd[0], fullCmd[1:]...).Output()
+	if err != nil {
+		return fmt.Sprintf("unreachable (%v)", err)
+	}
+	return strings.TrimSpace(string(output))
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Scripting mode: `slay run <script.yaml>` executes a declarative list of
+// actions (register agents, send messages, wait for replies, run sync) so
+// integration tests and demo environments can be spun up without driving
+// the TUI by hand.
+
+// ScriptAction is one declarative step; exactly one field should be set
+type ScriptAction struct {
+	Register *RegisterAction `yaml:"register"`
+	Send     *SendAction     `yaml:"send"`
+	Wait     *WaitAction     `yaml:"wait"`
+	Sync     bool            `yaml:"sync"`
+}
+
+// RegisterAction adds an agent to the registry
+type RegisterAction struct {
+	Name      string `yaml:"name"`
+	AgentType string `yaml:"agent_type"`
+	Directory string `yaml:"directory"`
+	Machine   string `yaml:"machine"` // Defaults to "host"
+}
+
+// SendAction delivers a message through msg
+type SendAction struct {
+	Agent   string `yaml:"agent"`
+	Message string `yaml:"message"`
+	From    string `yaml:"from"` // Optional sender for conversation logging
+}
+
+// WaitAction blocks until the agent logs a reply matching the pattern
+type WaitAction struct {
+	Agent   string `yaml:"agent"`
+	Pattern string `yaml:"pattern"`
+	Timeout int    `yaml:"timeout"` // Seconds (default 120)
+}
+
+// Script is a named list of actions loaded from a YAML file
+type Script struct {
+	Name    string         `yaml:"name"`
+	Actions []ScriptAction `yaml:"actions"`
+}
+
+// runScriptCommand handles `slay run <script.yaml>`
+func runScriptCommand(args []string) {
+	if len(args) < 1 {
+		fmt.Fprintf(os.Stderr, "Usage: slay run <script.yaml>\n")
+		os.Exit(1)
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	var script Script
+	if err := yaml.Unmarshal(data, &script); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid script file: %v\n", err)
+		os.Exit(1)
+	}
+	if len(script.Actions) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: script has no actions\n")
+		os.Exit(1)
+	}
+
+	if script.Name != "" {
+		fmt.Printf("Running script: %s\n", script.Name)
+	}
+
+	for i, action := range script.Actions {
+		if err := executeScriptAction(action); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: action %d failed: %v\n", i+1, err)
+			os.Exit(1)
+		}
+	}
+	fmt.Printf("Script complete (%d actions)\n", len(script.Actions))
+}
+
+// executeScriptAction dispatches a single script action
+func executeScriptAction(action ScriptAction) error {
+	switch {
+	case action.Register != nil:
+		return executeRegister(action.Register)
+	case action.Send != nil:
+		return executeSend(action.Send)
+	case action.Wait != nil:
+		return executeWait(action.Wait)
+	case action.Sync:
+		return executeSync()
+	}
+	return fmt.Errorf("action has no recognized type (register/send/wait/sync)")
+}
+
+func executeRegister(reg *RegisterAction) error {
+	if reg.Name == "" || reg.AgentType == "" || reg.Directory == "" {
+		return fmt.Errorf("register needs name, agent_type, and directory")
+	}
+
+	registry, err := NewRegistry()
+	if err != nil {
+		return err
+	}
+
+	machine := reg.Machine
+	if machine == "" {
+		machine = "host"
+	}
+	if err := registry.RegisterWithMachine(reg.Name, reg.AgentType, reg.Directory, machine); err != nil {
+		return err
+	}
+	fmt.Printf("  registered %s (%s) in %s\n", reg.Name, reg.AgentType, reg.Directory)
+	return nil
+}
+
+func executeSend(send *SendAction) error {
+	if send.Agent == "" || send.Message == "" {
+		return fmt.Errorf("send needs agent and message")
+	}
+
+	var cmd *exec.Cmd
+	if send.From != "" {
+		cmd = exec.Command("msg", "--from", send.From, send.Agent, send.Message)
+	} else {
+		cmd = exec.Command("msg", send.Agent, send.Message)
+	}
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("msg failed: %s", strings.TrimSpace(string(output)))
+	}
+	fmt.Printf("  sent to %s\n", send.Agent)
+	return nil
+}
+
+func executeWait(wait *WaitAction) error {
+	if wait.Agent == "" || wait.Pattern == "" {
+		return fmt.Errorf("wait needs agent and pattern")
+	}
+
+	timeout := 120 * time.Second
+	if wait.Timeout > 0 {
+		timeout = time.Duration(wait.Timeout) * time.Second
+	}
+
+	fmt.Printf("  waiting for %s to reply matching %q...\n", wait.Agent, wait.Pattern)
+	reply, err := waitForReply(wait.Agent, wait.Pattern, time.Now(), timeout)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("  got reply: %s\n", truncateReply(reply))
+	return nil
+}
+
+func executeSync() error {
+	synced, err := runRegistrySync()
+	if err != nil {
+		return fmt.Errorf("sync failed: %w", err)
+	}
+
+	fmt.Printf("  sync complete (%d files)\n", synced)
+	return nil
+}
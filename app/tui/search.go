@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+
+	"slaygent-manager/history"
+)
+
+// `slay search "<query>" [--agent X] [--since 7d]` searches message history
+// from the command line, so agents can recall prior decisions without
+// opening the TUI. Matches print with both conversation parties and a local
+// timestamp.
+
+// runSearchCommand handles `slay search`
+func runSearchCommand(args []string) {
+	var query, agent string
+	var since time.Duration
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--agent":
+			if i+1 >= len(args) {
+				fmt.Fprintln(os.Stderr, "Error: --agent requires a name")
+				os.Exit(1)
+			}
+			i++
+			agent = args[i]
+		case "--since":
+			if i+1 >= len(args) {
+				fmt.Fprintln(os.Stderr, "Error: --since requires a duration like 7d or 12h")
+				os.Exit(1)
+			}
+			i++
+			d, err := parseSinceDuration(args[i])
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			since = d
+		default:
+			if query != "" {
+				fmt.Fprintln(os.Stderr, "Usage: slay search \"<query>\" [--agent X] [--since 7d]")
+				os.Exit(1)
+			}
+			query = args[i]
+		}
+	}
+	if query == "" {
+		fmt.Fprintln(os.Stderr, "Usage: slay search \"<query>\" [--agent X] [--since 7d]")
+		os.Exit(1)
+	}
+
+	db, err := openEventsDB()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	sql := `
+		SELECT m.sender_name, m.receiver_name, m.message,
+		       datetime(m.sent_at, 'localtime'), COALESCE(m.compressed, 0)
+		FROM messages m
+		WHERE 1=1`
+	params := []interface{}{}
+	if agent != "" {
+		sql += ` AND (m.sender_name = ? OR m.receiver_name = ?)`
+		params = append(params, agent, agent)
+	}
+	if since > 0 {
+		sql += ` AND m.sent_at >= ?`
+		params = append(params, time.Now().UTC().Add(-since).Format("2006-01-02 15:04:05"))
+	}
+	sql += ` ORDER BY m.sent_at DESC LIMIT 500`
+
+	rows, err := db.Query(sql, params...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer rows.Close()
+
+	// Bodies may be stored compressed, so the match runs in Go after
+	// decompression rather than in SQL
+	needle := strings.ToLower(query)
+	matches := 0
+	for rows.Next() {
+		var sender, receiver, body, sentAt string
+		var compressed int
+		if err := rows.Scan(&sender, &receiver, &body, &sentAt, &compressed); err != nil {
+			continue
+		}
+		body = history.DecompressBody(body, compressed)
+		if !strings.Contains(strings.ToLower(body), needle) {
+			continue
+		}
+		matches++
+		fmt.Printf("[%s] %s → %s: %s\n", sentAt, sender, receiver, truncateMatch(body, 120))
+	}
+
+	if matches == 0 {
+		fmt.Println("No matches")
+	}
+}
+
+// parseSinceDuration parses shorthand durations like 7d, 12h, or 30m
+func parseSinceDuration(s string) (time.Duration, error) {
+	if len(s) < 2 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	value, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	switch s[len(s)-1] {
+	case 'd':
+		return time.Duration(value) * 24 * time.Hour, nil
+	case 'h':
+		return time.Duration(value) * time.Hour, nil
+	case 'm':
+		return time.Duration(value) * time.Minute, nil
+	}
+	return 0, fmt.Errorf("invalid duration %q (use d, h, or m)", s)
+}
+
+// truncateMatch keeps long bodies to one readable line, width-aware so a
+// multi-byte rune is never split mid-sequence
+func truncateMatch(body string, max int) string {
+	body = strings.ReplaceAll(body, "\n", " ")
+	if runewidth.StringWidth(body) > max {
+		return runewidth.Truncate(body, max, "...")
+	}
+	return body
+}
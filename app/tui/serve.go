@@ -0,0 +1,197 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"slaygent-manager/history"
+)
+
+// REST API mode: `slay serve` exposes the registry and message history over
+// HTTP so external tooling and dashboards can integrate without parsing
+// ~/.slaygent JSON files or the SQLite schema. Binds to localhost only
+// unless --addr says otherwise.
+//
+//	GET  /agents                                    registered agents
+//	POST /messages   {"agent","message","from"}     deliver via msg
+//	GET  /conversations                             conversation list
+//	GET  /conversations?agent_a=X&agent_b=Y         messages between a pair
+
+const defaultServeAddr = "127.0.0.1:7473"
+
+// runServeCommand handles `slay serve [--addr host:port]`
+func runServeCommand(args []string) {
+	addr := defaultServeAddr
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == "--addr" {
+			addr = args[i+1]
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/agents", handleAgents)
+	mux.HandleFunc("/messages", handleMessages)
+	mux.HandleFunc("/conversations", handleConversations)
+
+	fmt.Printf("slay REST API on http://%s\n", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// writeJSON sends a JSON response, logging failures to the debug log
+func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		debugLogError("serve", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+// handleAgents serves GET /agents from the registry
+func handleAgents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "use GET")
+		return
+	}
+
+	registry, err := NewRegistry()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, registry.GetAgents())
+}
+
+// handleMessages serves POST /messages, delivering through the msg binary so
+// every delivery path (remote agents, groups, hooks, logging) applies
+func handleMessages(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "use POST")
+		return
+	}
+
+	var req struct {
+		Agent   string `json:"agent"`
+		Message string `json:"message"`
+		From    string `json:"from"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if req.Agent == "" || req.Message == "" {
+		writeError(w, http.StatusBadRequest, "agent and message are required")
+		return
+	}
+
+	var cmd *exec.Cmd
+	if req.From != "" {
+		cmd = exec.Command("msg", "--from", req.From, req.Agent, req.Message)
+	} else {
+		cmd = exec.Command("msg", req.Agent, req.Message)
+	}
+	if output, err := cmd.CombinedOutput(); err != nil {
+		writeError(w, http.StatusBadGateway, strings.TrimSpace(string(output)))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "sent", "agent": req.Agent})
+}
+
+// handleConversations serves GET /conversations: without parameters the
+// conversation list, with agent_a and agent_b the messages between that pair
+func handleConversations(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "use GET")
+		return
+	}
+
+	db, err := openEventsDB()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer db.Close()
+
+	agentA := r.URL.Query().Get("agent_a")
+	agentB := r.URL.Query().Get("agent_b")
+	if agentA == "" || agentB == "" {
+		rows, err := db.Query(`
+			SELECT agent1_name, agent2_name, datetime(last_message_at, 'localtime')
+			FROM conversations ORDER BY last_message_at DESC`)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		defer rows.Close()
+
+		type conversation struct {
+			Agent1        string `json:"agent1"`
+			Agent2        string `json:"agent2"`
+			LastMessageAt string `json:"last_message_at"`
+		}
+		conversations := []conversation{}
+		for rows.Next() {
+			var conv conversation
+			if err := rows.Scan(&conv.Agent1, &conv.Agent2, &conv.LastMessageAt); err != nil {
+				continue
+			}
+			conversations = append(conversations, conv)
+		}
+		writeJSON(w, http.StatusOK, conversations)
+		return
+	}
+
+	limit := 50
+	if n, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && n > 0 {
+		limit = n
+	}
+
+	rows, err := db.Query(`
+		SELECT m.id, m.sender_name, m.receiver_name, m.message,
+		       datetime(m.sent_at, 'localtime'), COALESCE(m.compressed, 0)
+		FROM messages m
+		WHERE (m.sender_name = ? AND m.receiver_name = ?)
+		   OR (m.sender_name = ? AND m.receiver_name = ?)
+		ORDER BY m.sent_at DESC LIMIT ?`,
+		agentA, agentB, agentB, agentA, limit)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer rows.Close()
+
+	type message struct {
+		ID       int    `json:"id"`
+		Sender   string `json:"sender"`
+		Receiver string `json:"receiver"`
+		Message  string `json:"message"`
+		SentAt   string `json:"sent_at"`
+	}
+	messages := []message{}
+	for rows.Next() {
+		var msg message
+		var compressed int
+		if err := rows.Scan(&msg.ID, &msg.Sender, &msg.Receiver, &msg.Message, &msg.SentAt, &compressed); err != nil {
+			continue
+		}
+		msg.Message = history.DecompressBody(msg.Message, compressed)
+		messages = append(messages, msg)
+	}
+
+	// Query returned newest first - present in conversation order
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+	writeJSON(w, http.StatusOK, messages)
+}
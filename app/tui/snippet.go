@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// `slay snippet` prints shell integration snippets that self-register the
+// current pane's agent through `slay registry add`, so the TUI's manual
+// register step becomes optional.
+
+const zshSnippet = `# Slaygent self-registration - add to ~/.zshrc
+# Launch agents through slay-agent and they register themselves:
+#   slay-agent claude       (name defaults to <dirbase>-<type>)
+#   slay-agent claude api   (explicit name)
+slay-agent() {
+  local type="${1:-claude}"
+  local name="${2:-$(basename "$PWD")-${type}}"
+  slay registry add "$name" "$type" "$PWD"
+  shift $(( $# > 1 ? 2 : $# ))
+  command "$type" "$@"
+}`
+
+const tmuxSnippet = `# Slaygent self-registration - add to ~/.tmux.conf
+# Registers a claude agent for every new pane's directory:
+set-hook -g after-split-window 'run-shell "slay registry add \"$(basename #{pane_current_path})-claude\" claude \"#{pane_current_path}\""'
+set-hook -g after-new-window  'run-shell "slay registry add \"$(basename #{pane_current_path})-claude\" claude \"#{pane_current_path}\""'`
+
+// runSnippetCommand handles `slay snippet [zsh|tmux]`, printing the matching
+// snippet (or both when no shell is named)
+func runSnippetCommand(args []string) {
+	which := ""
+	if len(args) > 0 {
+		which = args[0]
+	}
+
+	switch which {
+	case "zsh":
+		fmt.Println(zshSnippet)
+	case "tmux":
+		fmt.Println(tmuxSnippet)
+	case "":
+		fmt.Println(zshSnippet)
+		fmt.Println()
+		fmt.Println(tmuxSnippet)
+	default:
+		fmt.Fprintln(os.Stderr, "Usage: slay snippet [zsh|tmux]")
+		os.Exit(1)
+	}
+}
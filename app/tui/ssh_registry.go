@@ -84,6 +84,7 @@ func (r *SSHRegistry) AddConnection(name, sshKey, connectCommand string) error {
 		ConnectCommand: connectCommand,
 	})
 
+	logEvent("ssh_register", name)
 	return r.Save()
 }
 
@@ -92,6 +93,7 @@ func (r *SSHRegistry) RemoveConnection(name string) error {
 	for i, machine := range r.machines {
 		if machine.Name == name {
 			r.machines = append(r.machines[:i], r.machines[i+1:]...)
+			logEvent("ssh_remove", name)
 			break
 		}
 	}
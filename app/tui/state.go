@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"slaygent-manager/views"
+)
+
+// Session persistence: the active view, selected conversation, and table
+// cursor are written to ~/.slaygent/tui-state.json on quit so relaunching
+// the TUI returns to where the user left off.
+
+type sessionState struct {
+	ViewMode       string `json:"view_mode"`
+	ConversationID int    `json:"conversation_id"`
+	TableRow       int    `json:"table_row"`
+}
+
+// sessionStatePath returns the state file location, creating the directory
+func sessionStatePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	slaygentDir := filepath.Join(home, ".slaygent")
+	os.MkdirAll(slaygentDir, 0755)
+	return filepath.Join(slaygentDir, "tui-state.json")
+}
+
+// saveSessionState snapshots the model to the state file. Best effort -
+// quitting should never fail because the state could not be written.
+func saveSessionState(m model) {
+	path := sessionStatePath()
+	if path == "" {
+		return
+	}
+
+	state := sessionState{
+		ViewMode: m.viewMode,
+		TableRow: m.table.GetHighlightedRowIndex(),
+	}
+	if m.historyModel != nil {
+		if conv := m.historyModel.GetSelectedConversation(); conv != nil {
+			state.ConversationID = conv.ID
+		}
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return
+	}
+	os.WriteFile(path, data, 0644)
+}
+
+// loadSessionState reads the state file, returning nil when absent or invalid
+func loadSessionState() *sessionState {
+	path := sessionStatePath()
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil // First launch
+	}
+
+	var state sessionState
+	if err := json.Unmarshal(data, &state); err != nil {
+		debugLog("WARN", "state", "tui-state.json is not valid JSON, starting fresh")
+		return nil
+	}
+	return &state
+}
+
+// restoreSession applies a saved state to a freshly built model
+func restoreSession(m model) model {
+	state := loadSessionState()
+	if state == nil {
+		return m
+	}
+
+	// Restore table cursor
+	if state.TableRow > 0 && state.TableRow < len(m.rows) {
+		m.table = m.table.WithHighlightedRow(state.TableRow)
+	}
+
+	// Restore the last active view where that makes sense on a fresh launch
+	switch state.ViewMode {
+	case "messages":
+		m = m.ensureHistory()
+		if m.historyModel != nil {
+			m.viewMode = "messages"
+			// Reselect the conversation the user was reading
+			for i, conv := range m.historyModel.GetConversations() {
+				if conv.ID == state.ConversationID {
+					m.historyModel.SelectedConv = i
+					break
+				}
+			}
+			if conv := m.historyModel.GetSelectedConversation(); conv != nil {
+				m.historyModel.LoadMessages(conv.ID)
+			}
+			m.selectedMessage = -1
+			m.updateMessagesViewport()
+		}
+	case "help":
+		if helpModel, err := views.NewHelpModel(m.width, m.height); err == nil {
+			m.helpModel = helpModel
+			m.viewMode = "help"
+		}
+	case "sync":
+		m = m.initializeSyncComponents()
+		m.viewMode = "sync"
+	case "ssh_connections":
+		m.viewMode = "ssh_connections"
+	}
+
+	return m
+}
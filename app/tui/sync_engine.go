@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"slaygent-manager/views"
+)
+
+// Native sync engine: reads registry.json and rewrites the marker block in
+// each local agent's CLAUDE.md directly. This replaced the Homebrew-installed
+// sync-claude.sh and its path-probing fallback chain, so sync works the same
+// on machines without bash or brew.
+
+// syncRegistryClause writes content between the registry markers of the
+// CLAUDE.md in every locally registered agent directory, creating the file
+// when it doesn't exist and leaving a .backup of ones it rewrites. Returns
+// how many files were written.
+func syncRegistryClause(content string) (int, error) {
+	registry, err := NewRegistry()
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	for _, dir := range localSyncDirectories(registry) {
+		path := filepath.Join(dir, "CLAUDE.md")
+		if existing, err := os.ReadFile(path); err == nil {
+			os.WriteFile(path+".backup", existing, 0644)
+		}
+		if err := writeFileContent(path, content); err == nil {
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// runRegistrySync syncs the default registry clause, the operation the old
+// sync-claude.sh performed
+func runRegistrySync() (int, error) {
+	return syncRegistryClause(expandClauseTemplate(views.DefaultRegistryClause))
+}
+
+// localSyncDirectories returns the unique directories of agents registered
+// on this machine - the only ones whose CLAUDE.md files exist locally
+func localSyncDirectories(registry *Registry) []string {
+	seen := make(map[string]bool)
+	var dirs []string
+	for _, agent := range registry.GetAgents() {
+		if agent.Machine != "host" || agent.Directory == "" || seen[agent.Directory] {
+			continue
+		}
+		seen[agent.Directory] = true
+		dirs = append(dirs, agent.Directory)
+	}
+	return dirs
+}
+
+// newClauseFile builds the content of a fresh CLAUDE.md: a project header
+// followed by the clause between its markers
+func newClauseFile(filePath, content string) string {
+	header := fmt.Sprintf("# %s\n", filepath.Base(filepath.Dir(filePath)))
+	return header + "\n<!-- SLAYGENT-REGISTRY-START -->\n" + content + "\n<!-- SLAYGENT-REGISTRY-END -->\n"
+}
+
+// spliceRegistryClause replaces the marker block of an existing file's
+// content (appending one when missing), preserving the file's BOM and line
+// ending convention
+func spliceRegistryClause(existing, content string) string {
+	bom, body := splitBOM(existing)
+	eol := fileLineEnding(body)
+	content = adaptLineEndings(content, eol)
+
+	startMarker := "<!-- SLAYGENT-REGISTRY-START -->"
+	endMarker := "<!-- SLAYGENT-REGISTRY-END -->"
+
+	startIdx := strings.Index(body, startMarker)
+	endIdx := strings.Index(body, endMarker)
+
+	if startIdx == -1 || endIdx == -1 {
+		// No registry section found - append a full marker block, so the
+		// next sync replaces it instead of appending another copy
+		return bom + body + eol + eol + startMarker + eol + content + eol + endMarker + eol
+	}
+
+	// Replace content between markers
+	before := body[:startIdx]
+	after := body[endIdx+len(endMarker):]
+	return bom + before + startMarker + eol + content + eol + endMarker + after
+}
+
+// syncRemoteMachine runs the registry clause sync against one SSH machine:
+// the remote registry names the directories, and each CLAUDE.md is read,
+// spliced locally, and written back over SSH. Returns files written.
+func syncRemoteMachine(conn SSHConnection, content string) (int, error) {
+	sshParts := sshCommandParts(conn)
+	if sshParts == nil {
+		return 0, fmt.Errorf("no connect command for %s", conn.Name)
+	}
+
+	agents := queryRemoteRegistry(conn)
+	if agents == nil {
+		return 0, fmt.Errorf("could not read %s's registry", conn.Name)
+	}
+
+	seen := make(map[string]bool)
+	updated := 0
+	for _, agent := range agents {
+		// On its home machine the remote registry lists its own agents
+		// as "host"
+		if !isRegistrableMachine(agent.Machine) || agent.Directory == "" || seen[agent.Directory] {
+			continue
+		}
+		seen[agent.Directory] = true
+
+		path := agent.Directory + "/CLAUDE.md"
+		existing, err := remoteReadFile(sshParts, path)
+		newContent := ""
+		if err != nil {
+			newContent = newClauseFile(path, content)
+		} else {
+			newContent = spliceRegistryClause(existing, content)
+		}
+		if err := remoteWriteFile(sshParts, path, newContent); err != nil {
+			debugLog("ERROR", "ssh", fmt.Sprintf("sync of %s on %s failed: %v", path, conn.Name, err))
+			continue
+		}
+		updated++
+	}
+	return updated, nil
+}
+
+// remoteReadFile cats one file over SSH, erroring when it doesn't exist
+func remoteReadFile(sshParts []string, path string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	fullCmd := append(append([]string{}, sshParts...), fmt.Sprintf("cat %q", path))
+	cmd := exec.CommandContext(ctx, fullCmd[0], fullCmd[1:]...)
+	countSubprocess()
+	output, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+// remoteWriteFile writes one file over SSH stdin, backing up any existing
+// copy first the same way local sync does
+func remoteWriteFile(sshParts []string, path, content string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	remoteCmd := fmt.Sprintf("cp %q %q 2>/dev/null; cat > %q", path, path+".backup", path)
+	fullCmd := append(append([]string{}, sshParts...), remoteCmd)
+	cmd := exec.CommandContext(ctx, fullCmd[0], fullCmd[1:]...)
+	cmd.Stdin = strings.NewReader(content)
+	countSubprocess()
+	return cmd.Run()
+}
+
+// syncEverywhere runs the default clause sync locally and on every machine
+// in the SSH registry, returning one report line per machine
+func syncEverywhere(sshRegistry *SSHRegistry) []string {
+	var report []string
+
+	if updated, err := runRegistrySync(); err != nil {
+		report = append(report, fmt.Sprintf("✗ host: %v", err))
+	} else {
+		report = append(report, fmt.Sprintf("✓ host: %d files synced", updated))
+	}
+
+	if sshRegistry == nil {
+		return report
+	}
+	content := expandClauseTemplate(views.DefaultRegistryClause)
+	for _, conn := range sshRegistry.GetConnections() {
+		if updated, err := syncRemoteMachine(conn, content); err != nil {
+			report = append(report, fmt.Sprintf("✗ %s: %v", conn.Name, err))
+		} else {
+			report = append(report, fmt.Sprintf("✓ %s: %d files synced", conn.Name, updated))
+		}
+	}
+	return report
+}
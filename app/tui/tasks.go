@@ -0,0 +1,236 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// Task queue: work items routed between agents get durable status beyond
+// free-text messages. Managed with `slay task add/assign/list/done` and
+// shown in the TUI Tasks view.
+
+const tasksSchema = `
+CREATE TABLE IF NOT EXISTS tasks (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	title TEXT NOT NULL,
+	assignee TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL DEFAULT 'open',
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);`
+
+// Task is one work item from the queue
+type Task struct {
+	ID       int64
+	Title    string
+	Assignee string
+	Status   string // open, assigned, done
+	Created  string
+}
+
+// openTasksDB opens messages.db and ensures the tasks table exists
+func openTasksDB() (*sql.DB, error) {
+	db, err := openEventsDB()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(tasksSchema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// loadTasks returns tasks, open/assigned first, newest within each group
+func loadTasks(includeDone bool) ([]Task, error) {
+	db, err := openTasksDB()
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	query := `
+		SELECT id, title, assignee, status, datetime(created_at, 'localtime')
+		FROM tasks`
+	if !includeDone {
+		query += ` WHERE status != 'done'`
+	}
+	query += ` ORDER BY CASE status WHEN 'done' THEN 1 ELSE 0 END, id DESC`
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []Task
+	for rows.Next() {
+		var t Task
+		if err := rows.Scan(&t.ID, &t.Title, &t.Assignee, &t.Status, &t.Created); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks, rows.Err()
+}
+
+// runTaskCommand handles `slay task <subcommand>`
+func runTaskCommand(args []string) {
+	if len(args) < 1 {
+		fmt.Fprintf(os.Stderr, "Usage:\n  slay task add <title> [--assign <agent>]\n  slay task assign <id> <agent>\n  slay task list [--all]\n  slay task done <id>\n")
+		os.Exit(1)
+	}
+
+	db, err := openTasksDB()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	switch args[0] {
+	case "add":
+		taskAdd(db, args[1:])
+	case "assign":
+		taskAssign(db, args[1:])
+	case "list":
+		taskList(args[1:])
+	case "done":
+		taskDone(db, args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown task subcommand %q\n", args[0])
+		os.Exit(1)
+	}
+}
+
+func taskAdd(db *sql.DB, args []string) {
+	var assignee string
+	var titleParts []string
+	for i := 0; i < len(args); i++ {
+		if args[i] == "--assign" && i+1 < len(args) {
+			assignee = args[i+1]
+			i++
+			continue
+		}
+		titleParts = append(titleParts, args[i])
+	}
+
+	title := strings.Join(titleParts, " ")
+	if title == "" {
+		fmt.Fprintf(os.Stderr, "Error: task title is required\n")
+		os.Exit(1)
+	}
+
+	status := "open"
+	if assignee != "" {
+		status = "assigned"
+	}
+
+	result, err := db.Exec(`INSERT INTO tasks (title, assignee, status) VALUES (?, ?, ?)`,
+		title, assignee, status)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	id, _ := result.LastInsertId()
+	fmt.Printf("Task #%d added: %s\n", id, title)
+	logEvent("task_add", fmt.Sprintf("#%d %s", id, title))
+	if assignee != "" {
+		notifyAssignee(assignee, id, title)
+	}
+}
+
+func taskAssign(db *sql.DB, args []string) {
+	if len(args) < 2 {
+		fmt.Fprintf(os.Stderr, "Usage: slay task assign <id> <agent>\n")
+		os.Exit(1)
+	}
+
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: bad task id %q\n", args[0])
+		os.Exit(1)
+	}
+	assignee := args[1]
+
+	var title string
+	if err := db.QueryRow(`SELECT title FROM tasks WHERE id = ?`, id).Scan(&title); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: task #%d not found\n", id)
+		os.Exit(1)
+	}
+
+	if _, err := db.Exec(`
+		UPDATE tasks SET assignee = ?, status = 'assigned', updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?`, assignee, id); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Task #%d assigned to %s\n", id, assignee)
+	logEvent("task_assign", fmt.Sprintf("#%d to %s", id, assignee))
+	notifyAssignee(assignee, id, title)
+}
+
+func taskList(args []string) {
+	includeDone := len(args) > 0 && args[0] == "--all"
+
+	tasks, err := loadTasks(includeDone)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(tasks) == 0 {
+		fmt.Println("No tasks")
+		return
+	}
+
+	for _, t := range tasks {
+		assignee := t.Assignee
+		if assignee == "" {
+			assignee = "-"
+		}
+		fmt.Printf("#%-4d %-8s %-12s %s\n", t.ID, t.Status, assignee, t.Title)
+	}
+}
+
+func taskDone(db *sql.DB, args []string) {
+	if len(args) < 1 {
+		fmt.Fprintf(os.Stderr, "Usage: slay task done <id>\n")
+		os.Exit(1)
+	}
+
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: bad task id %q\n", args[0])
+		os.Exit(1)
+	}
+
+	result, err := db.Exec(`
+		UPDATE tasks SET status = 'done', updated_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND status != 'done'`, id)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no open task #%d\n", id)
+		os.Exit(1)
+	}
+	fmt.Printf("Task #%d done\n", id)
+	logEvent("task_done", fmt.Sprintf("#%d", id))
+}
+
+// notifyAssignee tells the assigned agent about its new task through msg.
+// Best effort - the task stays queued even if the agent isn't reachable.
+func notifyAssignee(assignee string, id int64, title string) {
+	exec.Command("msg", assignee,
+		fmt.Sprintf("You have been assigned task #%d: %s (mark complete with: slay task done %d)", id, title, id)).Run()
+}
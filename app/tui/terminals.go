@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Alternative terminal backends for users not running tmux: WezTerm panes via
+// "wezterm cli list" and kitty windows via "kitten @ ls". Discovered agents
+// get pane IDs prefixed "wezterm:<id>" / "kitty:<id>" so the messenger knows
+// which delivery path to use.
+
+// wezTermPane is the subset of "wezterm cli list --format json" we need
+type wezTermPane struct {
+	PaneID int    `json:"pane_id"`
+	Title  string `json:"title"`
+	CWD    string `json:"cwd"` // file://host/path URL
+}
+
+// getWezTermPanes discovers AI agents running in WezTerm panes
+func getWezTermPanes(registry *Registry) [][]string {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "wezterm", "cli", "list", "--format", "json")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil // WezTerm not installed or not running
+	}
+
+	var panes []wezTermPane
+	if err := json.Unmarshal(output, &panes); err != nil {
+		return nil
+	}
+
+	var rows [][]string
+	for _, pane := range panes {
+		agentType := detectAgentType(pane.Title)
+		if agentType == "unknown" {
+			continue
+		}
+
+		directory := pane.CWD
+		if parsed, err := url.Parse(pane.CWD); err == nil && parsed.Path != "" {
+			directory = parsed.Path
+		}
+
+		paneID := "wezterm:" + strconv.Itoa(pane.PaneID)
+		rows = append(rows, buildTerminalRow(registry, paneID, directory, agentType))
+	}
+
+	return rows
+}
+
+// kitty "kitten @ ls" structure: os-windows -> tabs -> windows
+type kittyOSWindow struct {
+	Tabs []struct {
+		Windows []struct {
+			ID                  int `json:"id"`
+			ForegroundProcesses []struct {
+				Cmdline []string `json:"cmdline"`
+				CWD     string   `json:"cwd"`
+			} `json:"foreground_processes"`
+		} `json:"windows"`
+	} `json:"tabs"`
+}
+
+// getKittyPanes discovers AI agents running in kitty windows
+func getKittyPanes(registry *Registry) [][]string {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "kitten", "@", "ls")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil // kitty not installed or remote control disabled
+	}
+
+	var osWindows []kittyOSWindow
+	if err := json.Unmarshal(output, &osWindows); err != nil {
+		return nil
+	}
+
+	var rows [][]string
+	for _, osWindow := range osWindows {
+		for _, tab := range osWindow.Tabs {
+			for _, window := range tab.Windows {
+				for _, proc := range window.ForegroundProcesses {
+					agentType := detectAgentType(strings.Join(proc.Cmdline, " "))
+					if agentType == "unknown" {
+						continue
+					}
+
+					paneID := "kitty:" + strconv.Itoa(window.ID)
+					rows = append(rows, buildTerminalRow(registry, paneID, proc.CWD, agentType))
+					break
+				}
+			}
+		}
+	}
+
+	return rows
+}
+
+// buildTerminalRow creates a display row for a non-tmux terminal pane,
+// resolving registration status the same way tmux rows do.
+func buildTerminalRow(registry *Registry, paneID, directory, agentType string) []string {
+	name := paneID
+	registered := "✗"
+	if registry != nil && registry.IsRegisteredWithMachine(agentType, directory, "host") {
+		registered = "✓"
+		if regName := registry.GetNameWithMachine(agentType, directory, "host"); regName != "" {
+			name = regName
+		}
+	}
+
+	return []string{
+		paneID,
+		directory,
+		agentType,
+		name,
+		"idle",
+		"host",
+		registered,
+	}
+}
@@ -1,12 +1,15 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -15,18 +18,14 @@ import (
 
 // getTmuxPanesWithSSH returns tmux pane information from local and remote machines
 func getTmuxPanesWithSSH(registry *Registry, sshRegistry *SSHRegistry) ([][]string, error) {
-	var allRows [][]string
-
-	// Get local tmux panes
-	localRows, err := getLocalTmuxPanes()
-	if err == nil {
-		allRows = append(allRows, localRows...)
-	}
+	localRows, err := getLocalRows(registry)
+	allRows := append([][]string{}, localRows...)
 
 	// Get remote tmux panes if SSH registry is provided (for reference only)
 	if sshRegistry != nil {
-		remoteRows := getRemoteTmuxPanes(sshRegistry)
-		allRows = append(allRows, remoteRows...)
+		for _, conn := range sshRegistry.GetConnections() {
+			allRows = append(allRows, remoteRegistryRows(conn)...)
+		}
 	}
 
 	// If no local tmux server and no remote data, return error
@@ -34,6 +33,28 @@ func getTmuxPanesWithSSH(registry *Registry, sshRegistry *SSHRegistry) ([][]stri
 		return nil, err
 	}
 
+	return allRows, nil
+}
+
+// getLocalRows scans every local source (tmux, docker, WezTerm, kitty) and
+// annotates registration status from the local registry
+func getLocalRows(registry *Registry) ([][]string, error) {
+	var allRows [][]string
+
+	// Get local tmux panes
+	localRows, err := getLocalTmuxPanes()
+	if err == nil {
+		allRows = append(allRows, localRows...)
+	}
+
+	// Get agents running inside local docker containers
+	dockerRows := getDockerPanes(registry)
+	allRows = append(allRows, dockerRows...)
+
+	// Get agents running in non-tmux terminals (WezTerm, kitty)
+	allRows = append(allRows, getWezTermPanes(registry)...)
+	allRows = append(allRows, getKittyPanes(registry)...)
+
 	// Update registration status and name for each row
 	for i, row := range allRows {
 		if len(row) >= 7 {
@@ -43,22 +64,21 @@ func getTmuxPanesWithSSH(registry *Registry, sshRegistry *SSHRegistry) ([][]stri
 
 			// Only check local registry for local "host" agents
 			if machine == "host" && registry != nil {
-				if registry.IsRegisteredWithMachine(agentType, directory, machine) {
+				// Resolve per-pane so two same-type agents in one directory
+				// can carry different registrations
+				if name := registry.GetNameForPane(agentType, directory, machine, row[0]); name != "" {
 					allRows[i][6] = "✓"  // Update REGISTERED column
-					// Replace NAME column with registered name
-					if name := registry.GetNameWithMachine(agentType, directory, machine); name != "" {
-						allRows[i][3] = name  // Update NAME column with registered name
-					}
+					allRows[i][3] = name // Update NAME column with registered name
 				} else {
 					allRows[i][6] = "✗"  // Update REGISTERED column to not registered
 					allRows[i][3] = "NR"  // Not Registered
 				}
 			}
-			// Remote agents keep their registration status from their home machine (already set in getRemoteTmuxPanes)
+			// Remote agents keep their registration status from their home machine (already set in remoteRegistryRows)
 		}
 	}
 
-	return allRows, nil
+	return allRows, err
 }
 
 // getLocalTmuxPanes gets tmux panes from the local machine
@@ -72,9 +92,10 @@ func getLocalTmuxPanes() ([][]string, error) {
 	}
 
 	// Get pane information using tmux list-panes
-	format := "#{session_name}:#{session_id}:#{window_index}.#{pane_index}:#{pane_current_path}:#{pane_current_command}:#{?pane_active,active,idle}"
+	format := "#{session_name}:#{session_id}:#{window_index}.#{pane_index}:#{pane_current_path}:#{pane_current_command}:#{?pane_active,active,idle}:#{window_activity}:#{pane_pid}:#{session_group}"
 	cmd := exec.CommandContext(ctx, "tmux", "list-panes", "-a", "-F", format)
 
+	countSubprocess()
 	output, err := cmd.Output()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tmux panes: %w", err)
@@ -83,32 +104,30 @@ func getLocalTmuxPanes() ([][]string, error) {
 	return parseTmuxOutput(string(output))
 }
 
-// getRemoteTmuxPanes gets registered agents from remote registries only (simplified approach)
-func getRemoteTmuxPanes(sshRegistry *SSHRegistry) [][]string {
-	var allRemoteRows [][]string
-
-	for _, conn := range sshRegistry.GetConnections() {
-		// Get only registered agents from remote registry
-		remoteRegistry := queryRemoteRegistry(conn)
-
-		// Convert registered agents to display rows
-		for _, regAgent := range remoteRegistry {
-
-			// Create display row for registered agent
-			row := []string{
-				regAgent.Name + "@" + conn.Name, // Pane ID with machine identifier
-				regAgent.Directory,               // Directory
-				regAgent.AgentType,              // Agent type
-				regAgent.Name,                   // Display name (registered name)
-				"remote",                        // Status (remote registered agent)
-				conn.Name,                       // Machine name
-				"✓",                            // Registration status (always registered)
-			}
-			allRemoteRows = append(allRemoteRows, row)
+// remoteRegistryRows gets registered agents from one remote machine's
+// registry, verified against the remote tmux server: entries whose directory
+// has no live pane show as "stale" instead of being trusted as active
+func remoteRegistryRows(conn SSHConnection) [][]string {
+	var rows [][]string
+
+	agents, livePaths := queryRemoteState(conn)
+	for _, regAgent := range agents {
+		status := "remote"
+		if !livePaths[regAgent.Directory] {
+			status = "stale" // Registry entry with no pane in that directory
 		}
+		rows = append(rows, []string{
+			regAgent.Name + "@" + conn.Name, // Pane ID with machine identifier
+			regAgent.Directory,               // Directory
+			regAgent.AgentType,              // Agent type
+			regAgent.Name,                   // Display name (registered name)
+			status,                          // Status (verified remote agent or stale)
+			conn.Name,                       // Machine name
+			"✓",                            // Registration status (always registered)
+		})
 	}
 
-	return allRemoteRows
+	return rows
 }
 
 // Removed queryRemoteTmuxPanes - simplified approach uses registry-only detection
@@ -123,46 +142,122 @@ func expandSSHKey(path string) string {
 	return path
 }
 
-// queryRemoteRegistry gets registered agents from remote machine (copied from msg-ssh)
-func queryRemoteRegistry(conn SSHConnection) []RegisteredAgent {
-	// Build SSH command to query remote registry
+// sshCommandParts builds the SSH invocation for a connection, inserting the
+// key flag when one is configured
+func sshCommandParts(conn SSHConnection) []string {
 	sshParts := strings.Fields(conn.ConnectCommand)
 	if len(sshParts) == 0 {
 		return nil
 	}
-
-	// Add SSH key if specified
 	if conn.SSHKey != "" {
 		expandedKey := expandSSHKey(conn.SSHKey)
 		sshParts = append(sshParts[:1], append([]string{"-i", expandedKey}, sshParts[1:]...)...)
 	}
+	return sshParts
+}
+
+// remotePanesMarker separates the registry JSON from the pane listing in the
+// combined remote query output
+const remotePanesMarker = "---SLAYGENT-PANES---"
+
+// queryRemoteState fetches a remote machine's registry and the directories of
+// its live tmux panes in one SSH round trip, so registry entries can be
+// verified against what is actually running
+func queryRemoteState(conn SSHConnection) ([]RegisteredAgent, map[string]bool) {
+	sshParts := sshCommandParts(conn)
+	if sshParts == nil {
+		return nil, nil
+	}
 
-	// Query remote registry (same as msg-ssh)
-	remoteCmd := "cat ~/.slaygent/registry.json 2>/dev/null || echo '[]'"
+	remoteCmd := "cat ~/.slaygent/registry.json 2>/dev/null || echo '[]'; " +
+		"echo '" + remotePanesMarker + "'; " +
+		"tmux list-panes -a -F '#{pane_current_path}' 2>/dev/null"
 	fullCmd := append(sshParts, remoteCmd)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	cmd := exec.CommandContext(ctx, fullCmd[0], fullCmd[1:]...)
+	countSubprocess()
 	output, err := cmd.Output()
 	if err != nil {
-		return nil
+		debugLog("ERROR", "ssh", fmt.Sprintf("registry query for %s failed: %v", conn.Name, err))
+		return nil, nil
 	}
 
+	parts := strings.SplitN(string(output), remotePanesMarker, 2)
 	var agents []RegisteredAgent
-	if err := json.Unmarshal(output, &agents); err != nil {
-		return nil
+	if err := json.Unmarshal([]byte(parts[0]), &agents); err != nil {
+		debugLog("WARN", "ssh", fmt.Sprintf("registry from %s is not valid JSON: %v", conn.Name, err))
+		return nil, nil
 	}
 
+	livePaths := make(map[string]bool)
+	if len(parts) == 2 {
+		for _, line := range strings.Split(parts[1], "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				livePaths[line] = true
+			}
+		}
+	}
+
+	return agents, livePaths
+}
+
+// queryRemoteRegistry gets registered agents from remote machine (copied from msg-ssh)
+func queryRemoteRegistry(conn SSHConnection) []RegisteredAgent {
+	agents, _ := queryRemoteState(conn)
 	return agents
 }
 
+// pruneRemoteAgent removes one registration from a remote machine's registry
+// by rewriting its registry.json over SSH
+func pruneRemoteAgent(conn SSHConnection, name string) error {
+	sshParts := sshCommandParts(conn)
+	if sshParts == nil {
+		return fmt.Errorf("no connect command for %s", conn.Name)
+	}
+
+	agents := queryRemoteRegistry(conn)
+	filtered := make([]RegisteredAgent, 0, len(agents))
+	found := false
+	for _, agent := range agents {
+		if agent.Name == name {
+			found = true
+			continue
+		}
+		filtered = append(filtered, agent)
+	}
+	if !found {
+		return fmt.Errorf("agent '%s' not in %s's registry", name, conn.Name)
+	}
+
+	data, err := json.MarshalIndent(filtered, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	fullCmd := append(sshParts, "cat > ~/.slaygent/registry.json")
+	cmd := exec.CommandContext(ctx, fullCmd[0], fullCmd[1:]...)
+	cmd.Stdin = bytes.NewReader(data)
+	countSubprocess()
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to rewrite %s's registry: %w", conn.Name, err)
+	}
+
+	logEvent("ssh_remove", fmt.Sprintf("pruned stale %s from %s", name, conn.Name))
+	return nil
+}
+
 // Removed duplicate RegistryEntry - using existing RegisteredAgent struct
 
 // isTmuxRunning checks if tmux server is accessible
 func isTmuxRunning(ctx context.Context) bool {
 	cmd := exec.CommandContext(ctx, "tmux", "has-session")
+	countSubprocess()
 	err := cmd.Run()
 	return err == nil
 }
@@ -176,6 +271,17 @@ func parseTmuxOutput(output string) ([][]string, error) {
 	lines := strings.Split(strings.TrimSpace(output), "\n")
 	var rows [][]string
 
+	// One ps snapshot serves every node pane instead of spawning
+	// tmux/pgrep/ps per pane - taken lazily on first use
+	var snapshot *processSnapshot
+
+	// Track which panes are still alive so stale cache entries get pruned
+	seenPanes := make(map[string]bool)
+
+	// Grouped sessions share windows, so the same pane shows up once per
+	// session in list-panes output - dedupe by the pane's root PID
+	seenPanePIDs := make(map[string]bool)
+
 	for _, line := range lines {
 		if strings.TrimSpace(line) == "" {
 			continue
@@ -193,16 +299,69 @@ func parseTmuxOutput(output string) ([][]string, error) {
 		command := parts[4]          // current command
 		status := parts[5]           // active/idle
 
+		// Classify busy vs waiting from the last output timestamp when available
+		if len(parts) >= 7 {
+			if busyStatus := classifyPaneActivity(parts[6], time.Now()); busyStatus != "" {
+				status = busyStatus
+			}
+		}
+
 		// Use session name for pane targeting (works with both named and unnamed sessions)
 		// This is what tmux expects when targeting panes
 		fullPaneID := sessionName + ":" + windowPane
 
-		// Detect AI agent type - check direct command first
-		agentType := detectAgentType(command)
+		panePID := ""
+		if len(parts) >= 8 {
+			panePID = strings.TrimSpace(parts[7])
+		}
+		sessionGroup := ""
+		if len(parts) >= 9 {
+			sessionGroup = strings.TrimSpace(parts[8])
+		}
 
-		// For node processes, always check what's actually running
-		if command == "node" {
-			agentType = detectAgentInPane(fullPaneID)
+		// Skip duplicate appearances of the same pane via grouped sessions
+		if panePID != "" {
+			if seenPanePIDs[panePID] {
+				continue
+			}
+			seenPanePIDs[panePID] = true
+		}
+
+		// A pane running a tmux client hosts a nested session (often a
+		// remote tmux) - its inner panes belong to a different server and
+		// can't be inspected from here, so skip it rather than misdetect
+		if command == "tmux" {
+			continue
+		}
+
+		seenPanes[fullPaneID] = true
+
+		// Detect AI agent type - reuse the cached resolution unless the
+		// pane's PID or command changed since the last refresh
+		var agentType, envName string
+		if cached, ok := detectionCache[fullPaneID]; ok && cached.pid == panePID && cached.command == command {
+			agentType = cached.agentType
+			envName = cached.envName
+		} else {
+			// Pane environment beats process-name heuristics
+			agentType, envName = detectAgentFromEnv(fullPaneID)
+			if agentType == "" {
+				agentType = detectAgentType(command)
+
+				// For node processes, always check what's actually running
+				if command == "node" {
+					if snapshot == nil {
+						snapshot = takeProcessSnapshot()
+					}
+					agentType = snapshot.detectAgentUnder(panePID)
+				}
+			}
+			detectionCache[fullPaneID] = detectionCacheEntry{
+				pid:       panePID,
+				command:   command,
+				agentType: agentType,
+				envName:   envName,
+			}
 		}
 
 		// Skip non-AI agents (only show claude, opencode, coder, crush)
@@ -214,8 +373,15 @@ func parseTmuxOutput(output string) ([][]string, error) {
 		registered := "✗"
 		// We'll check registration after we have the model with registry
 
-		// Create display name using session name for better readability
+		// Create display name using session name for better readability,
+		// annotated with the group for grouped sessions
 		displayName := sessionName + ":" + windowPane
+		if sessionGroup != "" {
+			displayName = sessionGroup + "/" + displayName
+		}
+		if envName != "" {
+			displayName = envName
+		}
 
 		rows = append(rows, []string{
 			fullPaneID,     // Use session_name:window.pane for tmux targeting
@@ -228,9 +394,81 @@ func parseTmuxOutput(output string) ([][]string, error) {
 		})
 	}
 
+	// Drop cache entries for panes that no longer exist
+	for paneID := range detectionCache {
+		if !seenPanes[paneID] {
+			delete(detectionCache, paneID)
+		}
+	}
+
 	return rows, nil
 }
 
+// detectionCacheEntry remembers how a pane was resolved so refreshes only
+// re-run subprocess detection when the pane's PID or command changes.
+type detectionCacheEntry struct {
+	pid       string
+	command   string
+	agentType string
+	envName   string
+}
+
+// detectionCache maps pane ID to its last resolution, persisting between
+// refreshes to avoid repeated subprocess storms on every 'r' press.
+var detectionCache = make(map[string]detectionCacheEntry)
+
+// paneBusyThreshold is how recently a pane must have produced output to be
+// considered actively generating rather than waiting for input.
+const paneBusyThreshold = 5 * time.Second
+
+// classifyPaneActivity turns a #{window_activity} epoch timestamp into a
+// "busy" (actively generating output) or "idle" (waiting) status. Returns ""
+// when the timestamp can't be parsed so callers keep their existing status.
+func classifyPaneActivity(activityEpoch string, now time.Time) string {
+	epoch, err := strconv.ParseInt(strings.TrimSpace(activityEpoch), 10, 64)
+	if err != nil || epoch <= 0 {
+		return ""
+	}
+
+	if now.Sub(time.Unix(epoch, 0)) < paneBusyThreshold {
+		return "busy"
+	}
+	return "idle"
+}
+
+// detectAgentFromEnv checks the pane's tmux environment for an explicit
+// SLAYGENT_AGENT=<type>:<name> declaration. Agents that export this variable
+// (via "tmux set-environment") are identified directly, avoiding misdetection
+// of wrappers and shell scripts. Returns empty strings when not set.
+func detectAgentFromEnv(paneID string) (agentType, name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "tmux", "show-environment", "-t", paneID, "SLAYGENT_AGENT")
+	countSubprocess()
+	output, err := cmd.Output()
+	if err != nil {
+		return "", "" // Variable not set for this session
+	}
+
+	value := strings.TrimSpace(string(output))
+	// Output format: SLAYGENT_AGENT=<type>:<name> (or -SLAYGENT_AGENT when unset)
+	if !strings.HasPrefix(value, "SLAYGENT_AGENT=") {
+		return "", ""
+	}
+	value = strings.TrimPrefix(value, "SLAYGENT_AGENT=")
+
+	parts := strings.SplitN(value, ":", 2)
+	agentType = strings.ToLower(strings.TrimSpace(parts[0]))
+	if agentType == "" {
+		return "", ""
+	}
+	if len(parts) == 2 {
+		name = strings.TrimSpace(parts[1])
+	}
+	return agentType, name
+}
+
 // detectAgentType analyzes a tmux pane command to determine AI agent type
 func detectAgentType(command string) string {
 	command = strings.ToLower(command)
@@ -252,70 +490,77 @@ func detectAgentType(command string) string {
 	return "unknown"
 }
 
-// detectAgentInPane checks for AI agent by examining the process running in the pane
-func detectAgentInPane(paneID string) string {
-	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
-	defer cancel()
+// processSnapshot is a one-shot capture of the process table, used to resolve
+// what node panes are actually running without spawning tmux/pgrep/ps for
+// every pane on every refresh.
+type processSnapshot struct {
+	commands map[string]string   // pid -> full command line
+	children map[string][]string // ppid -> child pids
+}
 
-	// Get the PID of the process in this specific pane using display-message
-	// This ensures we get only one PID for the exact pane
-	pidCmd := exec.CommandContext(ctx, "tmux", "display-message", "-p", "-t", paneID, "#{pane_pid}")
-	pidOutput, err := pidCmd.Output()
-	if err != nil {
-		return "unknown"
+// takeProcessSnapshot captures the process table with a single ps call.
+// Returns an empty snapshot on failure so lookups degrade to "unknown".
+func takeProcessSnapshot() *processSnapshot {
+	snapshot := &processSnapshot{
+		commands: make(map[string]string),
+		children: make(map[string][]string),
 	}
 
-	pid := strings.TrimSpace(string(pidOutput))
-	if pid == "" {
-		return "unknown"
+	// Native Windows has no ps - fall back to wmic
+	if runtime.GOOS == "windows" {
+		windowsProcessSnapshot(snapshot)
+		return snapshot
 	}
 
-	// Get child processes of this PID (the shell's children)
-	pgrepCmd := exec.CommandContext(ctx, "pgrep", "-P", pid)
-	childPids, err := pgrepCmd.Output()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "ps", "-eo", "pid=,ppid=,command=")
+	countSubprocess()
+	output, err := cmd.Output()
 	if err != nil {
-		return "unknown"
+		return snapshot
 	}
 
-	// Check each child process
-	for _, childPid := range strings.Split(string(childPids), "\n") {
-		childPid = strings.TrimSpace(childPid)
-		if childPid == "" {
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
 			continue
 		}
+		pid := fields[0]
+		ppid := fields[1]
+		command := strings.Join(fields[2:], " ")
 
-		agentType := checkProcessCommand(ctx, childPid)
-		if agentType != "unknown" {
-			return agentType
-		}
+		snapshot.commands[pid] = command
+		snapshot.children[ppid] = append(snapshot.children[ppid], pid)
 	}
 
-	return "unknown"
+	return snapshot
 }
 
-// checkProcessCommand checks a single process to determine if it's an AI agent
-func checkProcessCommand(ctx context.Context, pid string) string {
-	// Get the command for this PID
-	psCmd := exec.CommandContext(ctx, "ps", "-p", pid, "-o", "command=")
-	cmdOutput, err := psCmd.Output()
-	if err != nil {
+// detectAgentUnder walks the descendants of a pane's root PID looking for a
+// known AI agent command.
+func (s *processSnapshot) detectAgentUnder(panePID string) string {
+	if panePID == "" {
 		return "unknown"
 	}
 
-	command := strings.ToLower(strings.TrimSpace(string(cmdOutput)))
+	visited := make(map[string]bool)
+	queue := append([]string{}, s.children[panePID]...)
 
-	// Check for AI agent commands (either direct or as arguments to node/python)
-	if strings.Contains(command, "claude") {
-		return "claude"
-	}
-	if strings.Contains(command, "opencode") {
-		return "opencode"
-	}
-	if strings.Contains(command, "coder") && !strings.Contains(command, "opencode") {
-		return "coder"
-	}
-	if strings.Contains(command, "crush") {
-		return "crush"
+	for len(queue) > 0 {
+		pid := queue[0]
+		queue = queue[1:]
+		if visited[pid] {
+			continue
+		}
+		visited[pid] = true
+
+		if agentType := detectAgentType(s.commands[pid]); agentType != "unknown" {
+			return agentType
+		}
+
+		queue = append(queue, s.children[pid]...)
 	}
 
 	return "unknown"
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/exec"
 	"strings"
 	"time"
 
@@ -13,6 +15,23 @@ import (
 	"slaygent-manager/views"
 )
 
+// toastExpireMsg clears a toast once its display window has passed
+type toastExpireMsg struct {
+	seq int
+}
+
+// showToast displays a transient notification for a few seconds. Failures
+// that used to be silently discarded in Update go through here.
+func (m model) showToast(text string) (model, tea.Cmd) {
+	m.toastText = text
+	m.toastSeq++
+	seq := m.toastSeq
+	debugLog("WARN", "toast", text)
+	return m, tea.Tick(4*time.Second, func(time.Time) tea.Msg {
+		return toastExpireMsg{seq: seq}
+	})
+}
+
 // updateMessagesViewport centralizes how we update the messages viewport
 // When focus is "conversations" or selectedMessage is -1: show all messages normally (faint)
 // When focus is "messages" and selectedMessage >= 0: show with selection highlighting
@@ -21,43 +40,38 @@ func (m *model) updateMessagesViewport() {
 		return
 	}
 
-	var content string
 	// If focus is on conversations panel OR no message selected, show normal view
 	if m.messagesFocus == "conversations" || m.selectedMessage < 0 {
-		content = m.historyModel.FormatMessages()  // All messages faint, no highlighting
-	} else {
-		// Focus is on messages panel AND a message is selected
-		content = m.historyModel.FormatMessagesWithSelection(m.selectedMessage)
+		content := m.historyModel.FormatMessages()  // All messages faint, no highlighting
+
+		// Wrap content to viewport width
+		wrappedContent := lipgloss.NewStyle().
+			Width(m.messagesViewport.Width).
+			Render(content)
+		m.messagesViewport.SetContent(wrappedContent)
+		return
 	}
 
-	// Wrap content to viewport width
+	// Focus is on messages panel AND a message is selected: render only the
+	// visible window of messages centered on the selection, so navigating a
+	// huge conversation doesn't re-style every message per keypress
+	viewportHeight := m.messagesViewport.Height
+	start := m.selectedMessage - (viewportHeight / 2)
+	if start < 0 {
+		start = 0
+	}
+	content := m.historyModel.FormatMessagesWindow(m.selectedMessage, start, viewportHeight)
+
 	wrappedContent := lipgloss.NewStyle().
 		Width(m.messagesViewport.Width).
 		Render(content)
 	m.messagesViewport.SetContent(wrappedContent)
-
-	// Scroll to keep selected message in view when navigating
-	if m.messagesFocus == "messages" && m.selectedMessage >= 0 {
-		// Count lines to find where the selected message is
-		lines := strings.Split(wrappedContent, "\n")
-		if m.selectedMessage < len(lines) {
-			// Calculate position - try to center the selected message
-			targetLine := m.selectedMessage
-			viewportHeight := m.messagesViewport.Height
-
-			// Calculate the line to scroll to (center the selected message if possible)
-			scrollTo := targetLine - (viewportHeight / 2)
-			if scrollTo < 0 {
-				scrollTo = 0
-			}
-
-			// Set the viewport position using the proper method
-			m.messagesViewport.SetYOffset(scrollTo)
-		}
-	}
+	m.messagesViewport.SetYOffset(0) // Window already starts at the right message
 }
 
-func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+// update is the real update pass; the timing wrapper in audit.go implements
+// tea.Model's Update
+func (m model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
@@ -91,7 +105,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Rebuild table with new width for flex columns
-		m.table = views.BuildBubbleTable(m.rows, m.registry, m.width)
+		m.table = views.BuildBubbleTable(m.rows, m.registry, m.width, m.pluginColumnTitles(), m.tableOptions())
 
 
 		return m, nil
@@ -113,6 +127,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.progress.SetPercent(0)
 		m.syncMessage = "" // Clear the success message
 		return m, nil
+	case syncEverywhereMsg:
+		// Consolidated per-machine report from a bulk remote sync
+		m.syncMessage = "Sync everywhere: " + strings.Join(msg.report, "  •  ")
+		return m, tea.Tick(6*time.Second, func(t time.Time) tea.Msg {
+			return resetProgressMsg{}
+		})
 	case syncProgressLogMsg:
 		// Add log to the sync progress logs
 		m.syncProgressLogs = append(m.syncProgressLogs, msg.log)
@@ -126,8 +146,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case syncProgressCompleteWithLogsMsg:
 		// Sync is complete with full logs
 		m.syncProgressActive = false
+		m.syncCancel = nil
 		m.syncProgressLogs = msg.logs // Replace with all collected logs
 		finalMsg := fmt.Sprintf("Sync complete: %d out of %d files updated successfully", msg.filesUpdated, msg.totalFiles)
+		if msg.cancelled {
+			finalMsg = fmt.Sprintf("Sync cancelled: %d of %d files updated, %d skipped", msg.filesUpdated, msg.totalFiles, msg.skipped)
+		}
 		m.syncProgressLogs = append(m.syncProgressLogs, finalMsg)
 		// Note: Keep spinner running to show completion state, it will be cleaned up on ESC
 		return m, nil
@@ -135,6 +159,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Sync failed
 		m.syncProgressActive = false
 		m.syncProgressError = msg.error
+		debugLog("ERROR", "sync", msg.error)
 		return m, nil
 	case spinner.TickMsg:
 		if m.syncProgressMode && m.syncProgressActive {
@@ -151,8 +176,16 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 			return m, tea.Batch(cmds...)
+		} else if len(m.refreshPending) > 0 {
+			var cmd tea.Cmd
+			m.refreshSpinner, cmd = m.refreshSpinner.Update(msg)
+			return m, cmd
 		}
 		return m, nil
+	case refreshSourceMsg:
+		// One refresh source finished - merge its rows and re-render
+		m = m.applyRefreshSource(msg)
+		return m, nil
 	case progress.FrameMsg:
 		if m.syncing {
 			progressModel, cmd := m.progress.Update(msg)
@@ -165,7 +198,8 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.error != "" {
 			m.filePickerError = msg.error
 		} else {
-			m.discoveredFiles = msg.files
+			// Pre-select whatever the last custom sync used
+			m.discoveredFiles = applySavedSelections(msg.files)
 			m.filePickerIndex = 0
 			m.filePickerError = ""
 		}
@@ -173,10 +207,89 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case fileDiscoveryTickMsg:
 		// Just for loading animation - no action needed
 		return m, nil
+	case toastExpireMsg:
+		// Ignore expiry from a toast that has since been replaced
+		if msg.seq == m.toastSeq {
+			m.toastText = ""
+		}
+		return m, nil
+	case updateAvailableMsg:
+		// Shown in the footer only - no interruption
+		m.updateNotice = msg.tag
+		return m, nil
+	case machineResourcesMsg:
+		if m.machineResources == nil {
+			m.machineResources = make(map[string]string)
+		}
+		m.machineResources[msg.name] = msg.readout
+		return m, nil
+	case panePreviewMsg:
+		// Captured pane content arrived; ignore if preview was dismissed
+		if m.viewMode == "preview" {
+			m.previewTitle = msg.title
+			m.previewContent = msg.content
+		}
+		return m, nil
+	case paneJumpMsg:
+		// The TUI resumed from a suspended SSH attach
+		return m.paneJumpResult(msg)
+	case remoteRowsMsg:
+		// Background SSH queries finished; merge remote rows under the
+		// local ones
+		if len(msg.rows) > 0 {
+			local := m.rows
+			if len(local) > 0 && local[0][0] == "INFO" {
+				local = nil // Drop the "no agents" placeholder
+			}
+			remote := appendPluginCells(filterIgnoredRows(msg.rows, m.ignoreList), pluginColumns(m.plugins))
+			m.rows = append(local, remote...)
+			m.degradedMode = false
+			m.table = views.BuildBubbleTable(m.rows, m.registry, m.width, m.pluginColumnTitles(), m.tableOptions())
+		}
+		return m, nil
+	case liveTailMsg:
+		// Live tail poll: reload from messages.db and follow new messages;
+		// leaving the view or toggling off lets the tick chain die out
+		if !m.liveTail || m.viewMode != "messages" {
+			return m, nil
+		}
+		if m.historyModel != nil {
+			current := m.historyModel.GetSelectedConversation()
+			m.historyModel.LoadConversations()
+			if current != nil {
+				// Reloading re-sorts by recency, so re-find the conversation
+				// being followed before reloading its messages
+				for i, conv := range m.historyModel.GetConversations() {
+					if conv.ID == current.ID {
+						m.historyModel.SelectedConv = i
+						break
+					}
+				}
+				before := len(m.historyModel.GetMessages())
+				m.historyModel.LoadMessages(current.ID)
+				if len(m.historyModel.GetMessages()) > before {
+					m.updateMessagesViewport()
+					m.messagesViewport.GotoBottom()
+				}
+			}
+		}
+		return m, liveTailTickCmd()
 	case refreshMsg:
 		// Auto-refresh disabled to prevent duplication
 		// Use manual refresh with 'r' key only
 	case tea.KeyMsg:
+		// Quit guard: confirm before exiting mid-sync
+		if m.quitConfirm {
+			switch msg.String() {
+			case "y", "Y":
+				saveSessionState(m)
+				return m, tea.Quit
+			default:
+				m.quitConfirm = false
+			}
+			return m, nil
+		}
+
 		// Sync confirmation removed - only use 'e' key for sync customization
 
 		// Handle SSH key selection mode
@@ -195,7 +308,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if len(m.sshKeys) > 0 && m.selectedSSHKey < len(m.sshKeys) {
 					m.tempSSHKey = m.sshKeys[m.selectedSSHKey]
 					m.inputMode = true
-					m.inputBuffer = ""
+					m.textInput = newPromptInput()
 					m.inputTarget = "ssh-command"
 				}
 			case "esc":
@@ -211,37 +324,179 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle input mode first
 		if m.inputMode {
+			var toastCmd tea.Cmd
 			switch msg.String() {
 			case "enter":
+				entered := m.textInput.Value()
 				// Handle different input targets
 				switch m.inputTarget {
 				case "register":
 					// Save agent registration with the entered name (only for local agents)
 					selectedRowIndex := m.table.GetHighlightedRowIndex()
-					if m.inputBuffer != "" && selectedRowIndex >= 0 && selectedRowIndex < len(m.rows) {
+					if entered != "" && selectedRowIndex >= 0 && selectedRowIndex < len(m.rows) {
 						row := m.rows[selectedRowIndex]
 						if len(row) >= 7 {  // Make sure we have machine column
 							agentType := row[2]     // AGENT column
 							fullDirectory := row[1] // DIRECTORY column (full path)
 							machine := row[5]       // MACHINE column
-							// Only allow registration of local agents (machine == "host")
-							if machine == "host" {
-								m.registry.RegisterWithMachine(m.inputBuffer, agentType, fullDirectory, machine)
+							// Only allow registration of locally reachable agents (host or docker)
+							if isRegistrableMachine(machine) {
+								// Bind to the pane when this type+directory already
+								// has a registration, so same-directory agents can
+								// be named separately
+								pane := ""
+								if m.registry.IsRegisteredWithMachine(agentType, fullDirectory, machine) {
+									pane = row[0] // PANE column
+								}
+								oldName := row[3] // NAME column ("NR" when unregistered)
+								if err := m.registry.RegisterWithPane(entered, agentType, fullDirectory, machine, pane); err != nil {
+									m, toastCmd = m.showToast("Failed to save registry: " + err.Error())
+								} else if m.config.RenameHistory && m.historyModel != nil &&
+									oldName != "" && oldName != "NR" && oldName != entered {
+									// Propagate the rename into message history so old
+									// and new names don't mix in conversations
+									if err := m.historyModel.RenameAgent(oldName, entered); err == nil {
+										logEvent("rename", oldName+" → "+entered)
+										m.historyModel.LoadConversations()
+									}
+								}
 							}
 						}
 					}
 					// Exit input mode
 					m.inputMode = false
-					m.inputBuffer = ""
 					m.inputTarget = ""
 					// Refresh everything
 					m = m.refreshAll()
 
+				case "color":
+					// Save (or with empty input clear) the agent's custom color
+					selectedRowIndex := m.table.GetHighlightedRowIndex()
+					if m.registry != nil && selectedRowIndex >= 0 && selectedRowIndex < len(m.rows) {
+						row := m.rows[selectedRowIndex]
+						if len(row) >= 7 && row[6] == "✓" {
+							if entered != "" && !isHexColor(entered) {
+								m, toastCmd = m.showToast("Invalid color - use hex like #FF8800")
+							} else if err := m.registry.SetColor(row[3], entered); err != nil {
+								m, toastCmd = m.showToast("Failed to save color: " + err.Error())
+							} else {
+								if m.historyModel != nil {
+									m.historyModel.SetAgentColors(m.registry.ColorOverrides())
+								}
+								m.table = views.BuildBubbleTable(m.rows, m.registry, m.width, m.pluginColumnTitles(), m.tableOptions())
+								if entered == "" {
+									m, toastCmd = m.showToast(fmt.Sprintf("Cleared color for %s", row[3]))
+								} else {
+									m, toastCmd = m.showToast(fmt.Sprintf("%s now shown in %s", row[3], entered))
+								}
+							}
+						}
+					}
+					m.inputMode = false
+					m.inputTarget = ""
+
+				case "groups":
+					// Save comma-separated group memberships for the selected agent
+					selectedRowIndex := m.table.GetHighlightedRowIndex()
+					if m.registry != nil && selectedRowIndex >= 0 && selectedRowIndex < len(m.rows) {
+						row := m.rows[selectedRowIndex]
+						if len(row) >= 7 && row[6] == "✓" {
+							var groups []string
+							for _, group := range strings.Split(entered, ",") {
+								group = strings.TrimPrefix(strings.TrimSpace(group), "@")
+								if group != "" {
+									groups = append(groups, group)
+								}
+							}
+							if err := m.registry.SetGroups(row[3], groups); err != nil {
+								m, toastCmd = m.showToast("Failed to save groups: " + err.Error())
+							} else if len(groups) == 0 {
+								m, toastCmd = m.showToast(fmt.Sprintf("Cleared groups for %s", row[3]))
+							} else {
+								m, toastCmd = m.showToast(fmt.Sprintf("%s now in @%s", row[3], strings.Join(groups, ", @")))
+							}
+						}
+					}
+					m.inputMode = false
+					m.inputTarget = ""
+
+				case "template":
+					// Expand the named template via msg and send it to the
+					// last speaker in the selected conversation
+					if entered != "" && m.historyModel != nil {
+						messages := m.historyModel.GetMessages()
+						if len(messages) > 0 {
+							target := messages[len(messages)-1].SenderName
+							send := exec.Command("msg", "--as-human", target, "--template", entered)
+							if err := send.Run(); err != nil {
+								m, toastCmd = m.showToast(fmt.Sprintf("Template %q failed - check the name", entered))
+							} else {
+								if conv := m.historyModel.GetSelectedConversation(); conv != nil {
+									m.historyModel.LoadMessages(conv.ID)
+									m.updateMessagesViewport()
+								}
+								m, toastCmd = m.showToast(fmt.Sprintf("Sent %s template to %s", entered, target))
+							}
+						}
+					}
+					m.inputMode = false
+					m.inputTarget = ""
+
+				case "annotate":
+					// Save (or clear) the note on the selected message
+					if m.historyModel != nil {
+						if err := m.historyModel.AnnotateMessage(m.annotateMessageID, entered); err != nil {
+							m, toastCmd = m.showToast("Failed to save note: " + err.Error())
+						} else {
+							if conv := m.historyModel.GetSelectedConversation(); conv != nil {
+								m.historyModel.LoadMessages(conv.ID)
+								m.updateMessagesViewport()
+							}
+							if entered == "" {
+								m, toastCmd = m.showToast("Note removed")
+							} else {
+								m, toastCmd = m.showToast("Note saved")
+							}
+						}
+					}
+					m.inputMode = false
+					m.inputTarget = ""
+
+				case "queue-edit":
+					// Replace a queued message's body before retrying
+					if m.historyModel != nil && m.queueIndex < len(m.queueItems) {
+						item := m.queueItems[m.queueIndex]
+						if entered == "" {
+							m, toastCmd = m.showToast("Edit cancelled - message unchanged")
+						} else if err := m.historyModel.UpdateMessageBody(item.ID, entered); err != nil {
+							m, toastCmd = m.showToast("Failed to update message: " + err.Error())
+						} else {
+							m = m.loadQueue()
+							m, toastCmd = m.showToast("Message updated - press 'r' to retry")
+						}
+					}
+					m.inputMode = false
+					m.inputTarget = ""
+
+				case "clause-name":
+					// Save the sync editor content as a named clause
+					if entered != "" && m.historyModel != nil {
+						if err := m.historyModel.SaveSyncClause(entered, m.syncEditor.Value()); err != nil {
+							m, toastCmd = m.showToast("Failed to save clause: " + err.Error())
+						} else {
+							m.historyModel.SetActiveSyncClause(entered)
+							m.syncClauseName = entered
+							m.syncModified = false
+							m, toastCmd = m.showToast(fmt.Sprintf("Saved clause '%s'", entered))
+						}
+					}
+					m.inputMode = false
+					m.inputTarget = ""
+
 				case "ssh-name":
 					// Save machine name and move to SSH key picker
-					if m.inputBuffer != "" {
-						m.tempSSHName = m.inputBuffer
-						m.inputBuffer = ""
+					if entered != "" {
+						m.tempSSHName = entered
 						m.inputTarget = "ssh-key-picker"
 						// Load SSH keys
 						m.sshKeys = getSSHKeys()
@@ -255,16 +510,18 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						m.tempSSHKey = m.sshKeys[m.selectedSSHKey]
 					}
 					m.inputMode = true
-					m.inputBuffer = ""
+					m.textInput = newPromptInput()
 					m.inputTarget = "ssh-command"
 
 				case "ssh-command":
 					// Save SSH connection and exit input mode
-					if m.inputBuffer != "" {
-						m.tempSSHCommand = m.inputBuffer
+					if entered != "" {
+						m.tempSSHCommand = entered
 						// Save the complete SSH connection
 						if m.sshRegistry != nil {
-							m.sshRegistry.AddConnection(m.tempSSHName, m.tempSSHKey, m.tempSSHCommand)
+							if err := m.sshRegistry.AddConnection(m.tempSSHName, m.tempSSHKey, m.tempSSHCommand); err != nil {
+								m, toastCmd = m.showToast("Failed to save SSH connection: " + err.Error())
+							}
 							// Refresh agents table to show new remote agents
 							m = m.refreshAll()
 						}
@@ -275,32 +532,28 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 					// Exit input mode
 					m.inputMode = false
-					m.inputBuffer = ""
 					m.inputTarget = ""
 				}
 			case "esc":
 				// Cancel input mode and clear temp SSH fields
 				m.inputMode = false
-				m.inputBuffer = ""
 				m.inputTarget = ""
 				m.tempSSHName = ""
 				m.tempSSHKey = ""
 				m.tempSSHCommand = ""
-			case "backspace", "delete":
-				if len(m.inputBuffer) > 0 {
-					m.inputBuffer = m.inputBuffer[:len(m.inputBuffer)-1]
-				}
 			default:
-				// Add character to buffer
-				if len(msg.String()) == 1 {
-					m.inputBuffer += msg.String()
-				}
+				// Everything else (typing, cursor movement, paste) goes to
+				// the text input
+				var inputCmd tea.Cmd
+				m.textInput, inputCmd = m.textInput.Update(msg)
+				return m, inputCmd
 			}
-			return m, nil
+			return m, toastCmd
 		}
 
-		// Handle file picker mode
+		// Handle file picker mode - rows mix project headers and files
 		if m.filePickerMode {
+			pickerRows := buildPickerRows(m.discoveredFiles, m.collapsedProjects)
 			switch msg.String() {
 			case "esc":
 				// Clean exit from file picker mode
@@ -309,22 +562,44 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.filePickerIndex = 0
 				m.filePickerLoading = false
 				m.filePickerError = ""
+				m.collapsedProjects = nil
 				// Reset all spinners to stop any pending ticks
 				m.filePickerSpinners = nil
 				return m, nil
 			case "up", "k":
-				if len(m.discoveredFiles) > 0 && m.filePickerIndex > 0 {
+				if len(pickerRows) > 0 && m.filePickerIndex > 0 {
 					m.filePickerIndex--
 				}
 				return m, nil
 			case "down", "j":
-				if len(m.discoveredFiles) > 0 && m.filePickerIndex < len(m.discoveredFiles)-1 {
+				if len(pickerRows) > 0 && m.filePickerIndex < len(pickerRows)-1 {
 					m.filePickerIndex++
 				}
 				return m, nil
-			case " ": // Space to toggle selection
-				if len(m.discoveredFiles) > 0 && m.filePickerIndex < len(m.discoveredFiles) {
-					m.discoveredFiles = toggleFileSelection(m.discoveredFiles, m.filePickerIndex)
+			case " ": // Space toggles a file, or a whole group on its header
+				if m.filePickerIndex < len(pickerRows) {
+					row := pickerRows[m.filePickerIndex]
+					if row.header {
+						m.discoveredFiles = toggleGroupSelection(m.discoveredFiles, row.project)
+					} else {
+						m.discoveredFiles = toggleFileSelection(m.discoveredFiles, row.fileIndex)
+					}
+				}
+				return m, nil
+			case "left", "right": // Collapse/expand the current row's group
+				if m.filePickerIndex < len(pickerRows) {
+					project := pickerRows[m.filePickerIndex].project
+					if m.collapsedProjects == nil {
+						m.collapsedProjects = make(map[string]bool)
+					}
+					m.collapsedProjects[project] = msg.String() == "left"
+					// Keep the cursor on the group when its files fold away
+					for i, row := range buildPickerRows(m.discoveredFiles, m.collapsedProjects) {
+						if row.header && row.project == project {
+							m.filePickerIndex = i
+							break
+						}
+					}
 				}
 				return m, nil
 			case "a", "A": // Select all
@@ -339,12 +614,22 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.discoveredFiles[i].Selected = strings.HasPrefix(m.discoveredFiles[i].Path, cwd)
 				}
 				return m, nil
+			case "i", "I": // Toggle gitignored/junk-dir files and re-discover
+				m.pickerShowIgnored = !m.pickerShowIgnored
+				m.filePickerLoading = true
+				m.filePickerError = ""
+				m.discoveredFiles = nil
+				m.filePickerIndex = 0
+				m.collapsedProjects = nil
+				return m, m.discoverFilesCommand()
 			case "enter":
 				// Execute sync on selected files
 				selectedCount := getSelectedCount(m.discoveredFiles)
 				if selectedCount > 0 {
-					// Get selected files for sync
+					// Get selected files for sync and remember the set for
+					// the next picker run
 					selectedFiles := getSelectedFiles(m.discoveredFiles)
+					saveSyncSelections(selectedFiles)
 
 					// Exit file picker mode and start sync progress
 					m.filePickerMode = false
@@ -360,17 +645,91 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("62"))
 					m.syncProgressSpinner = s
 
-					return m, tea.Batch(m.syncProgressSpinner.Tick, m.runSyncProgressCommand(selectedFiles))
+					logEvent("sync", fmt.Sprintf("%d files selected", len(selectedFiles)))
+
+					// Context so ESC can cancel the remaining files
+					ctx, cancel := context.WithCancel(context.Background())
+					m.syncCancel = cancel
+				return m, tea.Batch(m.syncProgressSpinner.Tick, m.runSyncProgressCommand(ctx, selectedFiles))
 				}
 				return m, nil
 			}
 			return m, nil
 		}
 
+		// Handle queue view - manage stuck (failed/detached) deliveries
+		if m.viewMode == "queue" {
+			switch msg.String() {
+			case "esc":
+				m.viewMode = "agents"
+				m.queueItems = nil
+				m.queueIndex = 0
+				return m, nil
+			case "up", "k":
+				if m.queueIndex > 0 {
+					m.queueIndex--
+				}
+				return m, nil
+			case "down", "j":
+				if len(m.queueItems) > 0 && m.queueIndex < len(m.queueItems)-1 {
+					m.queueIndex++
+				}
+				return m, nil
+			case "r":
+				// Retry delivery; the stale row is dropped on success
+				if m.queueIndex < len(m.queueItems) {
+					item := m.queueItems[m.queueIndex]
+					var toastCmd tea.Cmd
+					if err := m.retryQueuedMessage(item); err != nil {
+						m, toastCmd = m.showToast("Retry failed: " + err.Error())
+					} else {
+						m = m.loadQueue()
+						m, toastCmd = m.showToast(fmt.Sprintf("Re-sent to %s", item.Receiver))
+					}
+					return m, toastCmd
+				}
+				return m, nil
+			case "e":
+				// Edit the message body before retrying
+				if m.queueIndex < len(m.queueItems) {
+					m.inputMode = true
+					m.textInput = newPromptInput()
+					m.textInput.SetValue(m.queueItems[m.queueIndex].Body)
+					m.inputTarget = "queue-edit"
+				}
+				return m, nil
+			case "d":
+				// Drop the stuck delivery from the queue (and history)
+				if m.historyModel != nil && m.queueIndex < len(m.queueItems) {
+					var toastCmd tea.Cmd
+					if err := m.historyModel.DeleteMessage(m.queueItems[m.queueIndex].ID); err != nil {
+						m, toastCmd = m.showToast("Failed to drop message: " + err.Error())
+					} else {
+						m = m.loadQueue()
+						m, toastCmd = m.showToast("Message dropped")
+					}
+					return m, toastCmd
+				}
+				return m, nil
+			case "q", "ctrl+c":
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+
 		// Handle sync progress mode
 		if m.syncProgressMode {
 			switch msg.String() {
 			case "esc":
+				// First ESC while files are still being written cancels the
+				// remaining ones; the completion report shows what was skipped
+				if m.syncProgressActive && m.syncCancel != nil {
+					m.syncCancel()
+					m.syncCancel = nil
+					m.syncProgressLogs = append(m.syncProgressLogs, "Cancelling - finishing current file...")
+					return m, nil
+				}
+
 				// Clean exit from sync progress mode
 				m.syncProgressMode = false
 				m.syncProgressActive = false
@@ -388,7 +747,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 			case "q", "ctrl+c":
-				// Allow quit from sync progress
+				// Confirm before exiting while files are still being written
+				if m.syncProgressActive {
+					m.quitConfirm = true
+					return m, nil
+				}
+				saveSessionState(m)
 				return m, tea.Quit
 			}
 			// In sync progress mode, ignore other key inputs
@@ -396,12 +760,116 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Normal mode key handling
-		switch msg.String() {
+		// Help search prompt captures all keystrokes while open
+		if m.viewMode == "help" && m.helpModel != nil && m.helpModel.SearchActive() {
+			m.helpModel.HandleSearchKey(msg.String())
+			return m, nil
+		}
+
+		// Conversation quick-switcher: fuzzy-match participants and jump
+		if m.switcherMode {
+			matches := m.switcherMatches()
+			switch msg.String() {
+			case "esc", "ctrl+k":
+				m.switcherMode = false
+			case "enter":
+				if m.switcherIndex < len(matches) && m.historyModel != nil {
+					m.historyModel.SelectedConv = matches[m.switcherIndex]
+					if conv := m.historyModel.GetSelectedConversation(); conv != nil {
+						m.historyModel.LoadMessages(conv.ID)
+					}
+					m.selectedMessage = -1
+					m.messagesFocus = "conversations"
+					m.updateMessagesViewport()
+				}
+				m.switcherMode = false
+			case "up":
+				if m.switcherIndex > 0 {
+					m.switcherIndex--
+				}
+			case "down":
+				if m.switcherIndex < len(matches)-1 {
+					m.switcherIndex++
+				}
+			case "backspace":
+				if len(m.switcherQuery) > 0 {
+					m.switcherQuery = m.switcherQuery[:len(m.switcherQuery)-1]
+					m.switcherIndex = 0
+				}
+			default:
+				switch msg.Type {
+				case tea.KeyRunes:
+					m.switcherQuery += string(msg.Runes)
+				case tea.KeySpace:
+					m.switcherQuery += " "
+				default:
+					return m, nil
+				}
+				m.switcherIndex = 0
+			}
+			return m, nil
+		}
+
+		// Filter typing mode: every keystroke narrows the agents table
+		if m.filterMode {
+			switch msg.String() {
+			case "esc":
+				m.filterMode = false
+				m.filterQuery = ""
+			case "enter":
+				// Keep the filter applied and return to normal keys
+				m.filterMode = false
+				return m, nil
+			case "backspace":
+				if len(m.filterQuery) > 0 {
+					m.filterQuery = m.filterQuery[:len(m.filterQuery)-1]
+				}
+			case "up", "down":
+				// Allow row navigation while typing
+				m.table, cmd = m.table.Update(msg)
+				return m, cmd
+			default:
+				switch msg.Type {
+				case tea.KeyRunes:
+					m.filterQuery += string(msg.Runes)
+				case tea.KeySpace:
+					m.filterQuery += " "
+				default:
+					return m, nil
+				}
+			}
+			m.table = views.BuildBubbleTable(m.filteredRows(), m.registry, m.width, m.pluginColumnTitles(), m.tableOptions())
+			return m, nil
+		}
+
+		// View actions go through the configured keymap; the cases below
+		// switch on the default bindings
+		switch m.keys.translate(msg.String()) {
 		case "q", "ctrl+c":
+			// Confirm before exiting while a sync is still writing files
+			if m.syncing || m.syncProgressActive {
+				m.quitConfirm = true
+				return m, nil
+			}
+			saveSessionState(m)
 			return m, tea.Quit
+
+		case "/":
+			// Start filtering the agents table
+			if m.viewMode == "agents" {
+				m.filterMode = true
+				m.filterQuery = ""
+				return m, nil
+			}
+			// Start a help docs search
+			if m.viewMode == "help" && m.helpModel != nil {
+				m.helpModel.StartSearch()
+				return m, nil
+			}
 		case "m":
 			// Toggle to messages view
 			if m.viewMode == "agents" {
+				m = m.ensureHistory()
 				m.viewMode = "messages"
 				m.messagesFocus = "conversations" // Default focus to conversations panel
 
@@ -434,8 +902,193 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 		case "esc":
+			// Compare backs out to the messages view it was opened from
+			if m.viewMode == "compare" {
+				m.viewMode = "messages"
+				return m, nil
+			}
+			// An applied agents filter clears before anything else
+			if m.viewMode == "agents" && m.filterQuery != "" {
+				m.filterQuery = ""
+				m.table = views.BuildBubbleTable(m.rows, m.registry, m.width, m.pluginColumnTitles(), m.tableOptions())
+				return m, nil
+			}
 			// Return to agents view
-			if m.viewMode == "messages" || m.viewMode == "sync" || m.viewMode == "help" || m.viewMode == "ssh_connections" {
+			if m.viewMode == "messages" || m.viewMode == "sync" || m.viewMode == "help" || m.viewMode == "ssh_connections" || m.viewMode == "activity" || m.viewMode == "metrics" || m.viewMode == "plugins" || m.viewMode == "tasks" || m.viewMode == "logs" || m.viewMode == "doctor" || m.viewMode == "preview" {
+				m.viewMode = "agents"
+			}
+			return m, nil
+
+		case "t":
+			// Toggle to tasks view
+			if m.viewMode == "agents" {
+				m.viewMode = "tasks"
+				m.taskItems = nil
+				if tasks, err := loadTasks(true); err != nil {
+					debugLogError("tasks", err)
+				} else {
+					for _, t := range tasks {
+						m.taskItems = append(m.taskItems, views.TaskItem{
+							ID:       t.ID,
+							Title:    t.Title,
+							Assignee: t.Assignee,
+							Status:   t.Status,
+							Created:  t.Created,
+						})
+					}
+				}
+			} else if m.viewMode == "tasks" {
+				m.viewMode = "agents"
+			}
+			return m, nil
+
+		case "p":
+			// Toggle to plugins view (output of each view-capable plugin)
+			if m.viewMode == "agents" {
+				m.viewMode = "plugins"
+				m.pluginSections = nil
+				for _, p := range m.plugins {
+					if p.Manifest.View {
+						m.pluginSections = append(m.pluginSections, views.PluginSection{
+							Name:   p.Manifest.Name,
+							Output: pluginView(p),
+						})
+					}
+				}
+			} else if m.viewMode == "plugins" {
+				m.viewMode = "agents"
+			} else if m.viewMode == "sync" && m.syncMode != views.EditMode {
+				// Preview the clause exactly as sync will write it
+				if m.syncMode == views.PreviewMode {
+					m.syncMode = views.ViewMode
+				} else {
+					m.syncMode = views.PreviewMode
+				}
+			}
+			return m, nil
+
+		case "E":
+			// Sync the registry clause to every machine in the SSH registry
+			if m.viewMode == "sync" && m.syncMode != views.EditMode {
+				sshRegistry := m.sshRegistry
+				m.syncMessage = "Syncing everywhere..."
+				return m, func() tea.Msg {
+					return syncEverywhereMsg{report: syncEverywhere(sshRegistry)}
+				}
+			}
+
+		case "v":
+			// Toggle to activity (audit log) view
+			if m.viewMode == "agents" {
+				m.viewMode = "activity"
+				m.activityEvents = nil
+				m.activityPending = nil
+				for _, p := range loadPendingReplies() {
+					m.activityPending = append(m.activityPending, views.PendingReply{
+						Expecter: p.Expecter,
+						Replier:  p.Replier,
+						Deadline: p.Deadline,
+						Overdue:  p.Overdue,
+					})
+				}
+				if events, err := loadEvents(100); err != nil {
+					debugLogError("events", err)
+				} else {
+					for _, e := range events {
+						m.activityEvents = append(m.activityEvents, views.ActivityEvent{
+							Time:   e.CreatedAt,
+							Type:   e.Type,
+							Detail: e.Detail,
+						})
+					}
+				}
+			} else if m.viewMode == "activity" {
+				m.viewMode = "agents"
+			}
+			return m, nil
+
+		case "M":
+			// Toggle to per-agent metrics view
+			if m.viewMode == "agents" {
+				m.viewMode = "metrics"
+				m.metricsAgents = nil
+				if agents, err := loadAgentMetrics(); err != nil {
+					debugLogError("metrics", err)
+				} else {
+					m.metricsAgents = agents
+				}
+				return m, nil
+			} else if m.viewMode == "metrics" {
+				m.viewMode = "agents"
+				return m, nil
+			}
+
+		case "Q":
+			// Open the message queue (stuck deliveries) view
+			if m.viewMode == "agents" {
+				m = m.ensureHistory()
+				m.viewMode = "queue"
+				m.queueIndex = 0
+				m = m.loadQueue()
+				return m, nil
+			}
+
+		case "P":
+			// Preview the selected agent's pane (remote panes captured over SSH)
+			if m.viewMode == "agents" {
+				selectedRowIndex := m.table.GetHighlightedRowIndex()
+				if selectedRowIndex >= 0 && selectedRowIndex < len(m.rows) {
+					row := m.rows[selectedRowIndex]
+					if len(row) >= 7 {
+						m.viewMode = "preview"
+						m.previewTitle = fmt.Sprintf("%s on %s", row[3], row[5])
+						m.previewContent = "Capturing pane..."
+						return m, capturePaneCmd(row, m.sshRegistry)
+					}
+				}
+			} else if m.viewMode == "preview" {
+				m.viewMode = "agents"
+			}
+			return m, nil
+
+		case "D":
+			// Toggle to diagnostics view
+			if m.viewMode == "agents" {
+				m.viewMode = "doctor"
+				m.diagChecks = runDiagnostics()
+			} else if m.viewMode == "doctor" {
+				m.viewMode = "agents"
+			}
+			return m, nil
+
+		case "ctrl+k":
+			// Open the conversation quick-switcher
+			if m.viewMode == "messages" && m.historyModel != nil {
+				m.switcherMode = true
+				m.switcherQuery = ""
+				m.switcherIndex = 0
+				return m, nil
+			}
+
+		case "l":
+			// Toggle live tail in the messages view: poll messages.db and
+			// auto-scroll as new messages arrive, instead of manual refresh
+			if m.viewMode == "messages" {
+				m.liveTail = !m.liveTail
+				if m.liveTail {
+					var toastCmd tea.Cmd
+					m, toastCmd = m.showToast("Live tail on - following new messages")
+					return m, tea.Batch(toastCmd, liveTailTickCmd())
+				}
+				return m.showToast("Live tail off")
+			}
+
+		case "L":
+			// Toggle to debug logs view
+			if m.viewMode == "agents" {
+				m.viewMode = "logs"
+				m.logLines = readDebugLog(200)
+			} else if m.viewMode == "logs" {
 				m.viewMode = "agents"
 			}
 			return m, nil
@@ -447,6 +1100,10 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.sshSelectedIndex = 0
 				m.sshDeleteConfirm = false
 				m.sshDeleteTarget = 0
+				// Kick off background resource readouts per machine
+				if cmds := fetchMachineResourcesCmds(m.sshRegistry); len(cmds) > 0 {
+					return m, tea.Batch(cmds...)
+				}
 			} else if m.viewMode == "ssh_connections" {
 				m.viewMode = "agents"
 			}
@@ -469,6 +1126,38 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		// 's' key removed - use 'e' for sync customization only
 		case "c":
+			// Mark a conversation for compare, then open the side-by-side
+			// view when a second one is chosen
+			if m.viewMode == "messages" && m.messagesFocus == "conversations" && m.historyModel != nil {
+				conv := m.historyModel.GetSelectedConversation()
+				if conv == nil {
+					return m, nil
+				}
+				title := conv.Agent1Name + " ↔ " + conv.Agent2Name
+				if m.compareFirstID == 0 || m.compareFirstID == conv.ID {
+					m.compareFirstID = conv.ID
+					m.compareFirstTitle = title
+					return m.showToast("Marked " + title + " - select another conversation and press 'c'")
+				}
+
+				left, err := m.loadCompareSide(m.compareFirstID)
+				if err == nil {
+					var right []views.CompareMessage
+					if right, err = m.loadCompareSide(conv.ID); err == nil {
+						m.compareData = views.CompareViewData{
+							LeftTitle:  m.compareFirstTitle,
+							RightTitle: title,
+							Left:       left,
+							Right:      right,
+						}
+						m.compareFirstID = 0
+						m.viewMode = "compare"
+						return m, nil
+					}
+				}
+				m.compareFirstID = 0
+				return m.showToast("Compare failed: " + err.Error())
+			}
 			if m.viewMode == "sync" && m.syncMode != views.EditMode {
 				// Start file picker for custom sync
 				m.filePickerMode = true
@@ -476,6 +1165,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.filePickerError = ""
 				m.discoveredFiles = nil
 				m.filePickerIndex = 0
+				m.pickerShowIgnored = m.config.DiscoverIgnored
 
 				// Initialize 7 different spinners for file discovery
 				spinnerTypes := []spinner.Spinner{
@@ -520,6 +1210,8 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if m.historyModel != nil {
 					m.updateMessagesViewport()
 				}
+			} else if m.viewMode == "sync" && m.syncMode != views.EditMode {
+				return m.switchSyncClause(-1)
 			}
 			return m, nil
 		case "right":
@@ -539,6 +1231,8 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						m.messagesViewport.GotoTop()
 					}
 				}
+			} else if m.viewMode == "sync" && m.syncMode != views.EditMode {
+				return m.switchSyncClause(1)
 			}
 			return m, nil
 		case "up", "k":
@@ -637,8 +1331,11 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		case "r":
 			if m.viewMode == "agents" {
-				// Manual refresh - sync everything
-				m = m.refreshAll()
+				// Manual refresh - one async command per source so a slow
+				// remote doesn't block the table
+				var refreshCmd tea.Cmd
+				m, refreshCmd = m.startRefresh()
+				return m, refreshCmd
 			} else if m.viewMode == "messages" {
 				// Refresh message history
 				if m.historyModel != nil {
@@ -659,7 +1356,44 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Edit/customize sync clauses
 				m.viewMode = "sync"
 				m = m.initializeSyncComponents()
+			} else if m.viewMode == "messages" && m.historyModel != nil {
+				// Mark the conversation done and export its transcript
+				conv := m.historyModel.GetSelectedConversation()
+				if conv != nil {
+					m.historyModel.LoadMessages(conv.ID)
+					var toastCmd tea.Cmd
+					path, err := exportTranscript(conv, m.historyModel.Transcript(), m.config)
+					if err != nil {
+						m, toastCmd = m.showToast("Export failed: " + err.Error())
+					} else {
+						logEvent("conversation_done", fmt.Sprintf("%s ↔ %s exported to %s", conv.Agent1Name, conv.Agent2Name, path))
+						m, toastCmd = m.showToast("Exported to " + path)
+					}
+					return m, toastCmd
+				}
+			}
+		case "T":
+			// Compose from a coordination template, sent to whichever agent
+			// spoke last in the selected conversation
+			if m.viewMode == "messages" && m.historyModel != nil && len(m.historyModel.GetMessages()) > 0 {
+				m.inputMode = true
+				m.textInput = newPromptInput()
+				m.inputTarget = "template"
+				return m, nil
 			}
+		case "N":
+			// Attach a note to the selected message (empty note removes it)
+			if m.viewMode == "messages" && m.messagesFocus == "messages" && m.historyModel != nil {
+				messages := m.historyModel.GetMessages()
+				if m.selectedMessage >= 0 && m.selectedMessage < len(messages) {
+					m.annotateMessageID = messages[m.selectedMessage].ID
+					m.inputMode = true
+					m.textInput = newPromptInput()
+					m.textInput.SetValue(messages[m.selectedMessage].Note)
+					m.inputTarget = "annotate"
+				}
+			}
+			return m, nil
 		case "?":
 			if m.viewMode == "agents" {
 				// Show help view
@@ -678,6 +1412,43 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.helpModel.Update(m.width, m.height)
 				}
 			}
+		case "g":
+			// Toggle markdown rendering of message bodies
+			if m.viewMode == "messages" && m.historyModel != nil {
+				m.historyModel.SetMarkdownWidth(m.messagesViewport.Width - 2)
+				var toastCmd tea.Cmd
+				if m.historyModel.ToggleMarkdown() {
+					m, toastCmd = m.showToast("Markdown rendering on")
+				} else {
+					m, toastCmd = m.showToast("Markdown rendering off")
+				}
+				m.updateMessagesViewport()
+				return m, toastCmd
+			}
+		case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+			// Quick replies in the messages view: send the Nth canned reply
+			// to whichever agent spoke last in the selected conversation
+			if m.viewMode == "messages" && m.historyModel != nil {
+				replies := m.config.quickReplies()
+				idx := int(msg.String()[0] - '1')
+				messages := m.historyModel.GetMessages()
+				if idx < len(replies) && len(messages) > 0 {
+					target := messages[len(messages)-1].SenderName
+					var toastCmd tea.Cmd
+					send := exec.Command("msg", "--as-human", target, replies[idx].Text)
+					if err := send.Run(); err != nil {
+						m, toastCmd = m.showToast("Quick reply failed: " + err.Error())
+						return m, toastCmd
+					}
+					if conv := m.historyModel.GetSelectedConversation(); conv != nil {
+						m.historyModel.LoadMessages(conv.ID)
+						m.updateMessagesViewport()
+					}
+					m, toastCmd = m.showToast("Sent to " + target + ": " + replies[idx].Text)
+					return m, toastCmd
+				}
+				return m, nil
+			}
 		case "d":
 			// Delete SSH connection when in ssh_connections view
 			if m.viewMode == "ssh_connections" && !m.sshDeleteConfirm {
@@ -709,15 +1480,21 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						// Remove the connection
 						targetName := connections[m.sshDeleteTarget].Name
 						err := m.sshRegistry.RemoveConnection(targetName)
-						if err == nil {
-							// Adjust selection if needed
-							connCount := len(m.sshRegistry.GetConnections())
-							if m.sshSelectedIndex >= connCount && connCount > 0 {
-								m.sshSelectedIndex = connCount - 1
-							}
-							// Refresh agents table to remove stale remote agents
-							m = m.refreshAll()
+						debugLogError("ssh", err)
+						if err != nil {
+							var toastCmd tea.Cmd
+							m, toastCmd = m.showToast("Failed to remove SSH connection: " + err.Error())
+							m.sshDeleteConfirm = false
+							m.sshDeleteTarget = 0
+							return m, toastCmd
+						}
+						// Adjust selection if needed
+						connCount := len(m.sshRegistry.GetConnections())
+						if m.sshSelectedIndex >= connCount && connCount > 0 {
+							m.sshSelectedIndex = connCount - 1
 						}
+						// Refresh agents table to remove stale remote agents
+						m = m.refreshAll()
 					}
 				}
 				m.sshDeleteConfirm = false
@@ -728,17 +1505,29 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.deleteConfirm {
 				if m.historyModel != nil {
 					err := m.historyModel.DeleteConversation(m.deleteTarget)
-					if err == nil {
-						// Successfully deleted, reload conversations
-						m.historyModel.LoadConversations()
-						// Clear message panel
-						m.messagesViewport.SetContent("")
-							}
+					debugLogError("db", err)
+					if err != nil {
+						var toastCmd tea.Cmd
+						m, toastCmd = m.showToast("Failed to delete conversation: " + err.Error())
+						m.deleteConfirm = false
+						m.deleteTarget = 0
+						return m, toastCmd
+					}
+					// Successfully deleted, reload conversations
+					logEvent("conversation_delete", fmt.Sprintf("conversation %d", m.deleteTarget))
+					m.historyModel.LoadConversations()
+					// Clear message panel
+					m.messagesViewport.SetContent("")
 				}
 				m.deleteConfirm = false
 				m.deleteTarget = 0
 			}
 		case "n":
+			// Jump to the next help search match
+			if m.viewMode == "help" && m.helpModel != nil {
+				m.helpModel.NextMatch()
+				return m, nil
+			}
 			// Cancel SSH connection deletion
 			if m.sshDeleteConfirm {
 				m.sshDeleteConfirm = false
@@ -750,6 +1539,109 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.deleteConfirm = false
 				m.deleteTarget = 0
 			}
+		case "X":
+			// Prune a stale remote agent from its machine's registry
+			if m.viewMode == "agents" && m.sshRegistry != nil {
+				selectedRowIndex := m.table.GetHighlightedRowIndex()
+				if selectedRowIndex >= 0 && selectedRowIndex < len(m.rows) {
+					row := m.rows[selectedRowIndex]
+					var toastCmd tea.Cmd
+					if len(row) < 7 || row[4] != "stale" {
+						m, toastCmd = m.showToast("Only stale remote agents can be pruned")
+						return m, toastCmd
+					}
+					for _, conn := range m.sshRegistry.GetConnections() {
+						if conn.Name == row[5] {
+							if err := pruneRemoteAgent(conn, row[3]); err != nil {
+								m, toastCmd = m.showToast("Prune failed: " + err.Error())
+							} else {
+								m = m.refreshAll()
+								m, toastCmd = m.showToast(fmt.Sprintf("Pruned %s from %s", row[3], row[5]))
+							}
+							return m, toastCmd
+						}
+					}
+					m, toastCmd = m.showToast("No SSH connection named " + row[5])
+					return m, toastCmd
+				}
+			}
+
+		case "o":
+			// Jump to the highlighted agent's pane - switch-client locally,
+			// attach over SSH for remote rows
+			if m.viewMode == "agents" {
+				selectedRowIndex := m.table.GetHighlightedRowIndex()
+				if selectedRowIndex >= 0 && selectedRowIndex < len(m.rows) {
+					row := m.rows[selectedRowIndex]
+					if len(row) >= 7 {
+						return m.jumpToPane(row)
+					}
+				}
+			}
+
+		case "Z":
+			// Toggle do-not-disturb for the selected registered agent
+			if m.viewMode == "agents" && m.registry != nil {
+				selectedRowIndex := m.table.GetHighlightedRowIndex()
+				if selectedRowIndex >= 0 && selectedRowIndex < len(m.rows) {
+					row := m.rows[selectedRowIndex]
+					if len(row) >= 7 && row[6] == "✓" {
+						var toastCmd tea.Cmd
+						on, err := m.registry.ToggleDND(row[3])
+						if err != nil {
+							m, toastCmd = m.showToast("Failed to toggle DND: " + err.Error())
+						} else if on {
+							m, toastCmd = m.showToast(fmt.Sprintf("%s is now do-not-disturb 🌙 - messages will queue", row[3]))
+						} else {
+							m, toastCmd = m.showToast(fmt.Sprintf("%s is accepting messages again", row[3]))
+						}
+						m.table = views.BuildBubbleTable(m.rows, m.registry, m.width, m.pluginColumnTitles(), m.tableOptions())
+						return m, toastCmd
+					}
+					var toastCmd tea.Cmd
+					m, toastCmd = m.showToast("Register the agent first to set do-not-disturb")
+					return m, toastCmd
+				}
+			}
+
+		case "C":
+			// Assign a custom display color to the selected registered agent
+			if m.viewMode == "agents" && m.registry != nil {
+				selectedRowIndex := m.table.GetHighlightedRowIndex()
+				if selectedRowIndex >= 0 && selectedRowIndex < len(m.rows) {
+					row := m.rows[selectedRowIndex]
+					if len(row) >= 7 && row[6] == "✓" {
+						m.inputMode = true
+						m.textInput = newPromptInput()
+						m.textInput.SetValue(m.registry.GetColor(row[3]))
+						m.inputTarget = "color"
+						return m, nil
+					}
+					var toastCmd tea.Cmd
+					m, toastCmd = m.showToast("Register the agent first to assign a color")
+					return m, toastCmd
+				}
+			}
+
+		case "G":
+			// Edit group memberships of the selected registered agent
+			if m.viewMode == "agents" && m.registry != nil {
+				selectedRowIndex := m.table.GetHighlightedRowIndex()
+				if selectedRowIndex >= 0 && selectedRowIndex < len(m.rows) {
+					row := m.rows[selectedRowIndex]
+					if len(row) >= 7 && row[6] == "✓" {
+						m.inputMode = true
+						m.textInput = newPromptInput()
+						m.textInput.SetValue(strings.Join(m.registry.GetGroups(row[3]), ", "))
+						m.inputTarget = "groups"
+						return m, nil
+					}
+					var toastCmd tea.Cmd
+					m, toastCmd = m.showToast("Register the agent first to assign groups")
+					return m, toastCmd
+				}
+			}
+
 		case "a":
 			// Register agent - enter input mode (only for local agents)
 			selectedRowIndex := m.table.GetHighlightedRowIndex()
@@ -760,29 +1652,112 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					fullDirectory := row[1] // DIRECTORY column (full path)
 					machine := row[5]       // MACHINE column
 
-					// Only allow registration/deregistration for local agents
-					if machine == "host" {
-						if m.registry.IsRegisteredWithMachine(agentType, fullDirectory, machine) {
-							// Already registered, deregister it
-							m.registry.DeregisterWithMachine(agentType, fullDirectory, machine)
+					// Only allow registration/deregistration for locally reachable agents
+					if isRegistrableMachine(machine) {
+						if m.registry.GetNameForPane(agentType, fullDirectory, machine, row[0]) != "" {
+							// Already registered, deregister this pane's binding
+							// (or the legacy unbound entry)
+							m.registry.DeregisterWithPane(agentType, fullDirectory, machine, row[0])
 							// Refresh everything
 							m = m.refreshAll()
 						} else {
-							// Enter input mode to get name
+							// Enter input mode to get name, prefilled from
+							// name_template when one is configured
 							m.inputMode = true
-							m.inputBuffer = ""
+							m.textInput = newPromptInput()
 							m.inputTarget = "register"
+							if suggested := suggestAgentName(m.config.NameTemplate, agentType, fullDirectory, machine, m.nameTaken); suggested != "" {
+								m.textInput.SetValue(suggested)
+							}
 						}
 					}
 					// Ignore 'a' key for remote agents (machine != "host")
 				}
 			}
+		case "A":
+			// Bulk-register every unregistered local agent using name_template
+			if m.viewMode == "agents" && m.config.NameTemplate != "" {
+				registered := 0
+				for _, row := range m.rows {
+					if len(row) < 7 {
+						continue
+					}
+					agentType := row[2]
+					fullDirectory := row[1]
+					machine := row[5]
+					if !isRegistrableMachine(machine) || m.registry.GetNameForPane(agentType, fullDirectory, machine, row[0]) != "" {
+						continue
+					}
+					pane := ""
+					if m.registry.IsRegisteredWithMachine(agentType, fullDirectory, machine) {
+						pane = row[0]
+					}
+					name := suggestAgentName(m.config.NameTemplate, agentType, fullDirectory, machine, m.nameTaken)
+					if name == "" {
+						continue
+					}
+					if err := m.registry.RegisterWithPane(name, agentType, fullDirectory, machine, pane); err == nil {
+						registered++
+					}
+				}
+				m = m.refreshAll()
+				var toastCmd tea.Cmd
+				m, toastCmd = m.showToast(fmt.Sprintf("Registered %d agent(s)", registered))
+				return m, toastCmd
+			}
+		case "H":
+			// Toggle the selected row on the persisted ignore list
+			if m.viewMode == "agents" {
+				selectedRowIndex := m.table.GetHighlightedRowIndex()
+				if selectedRowIndex >= 0 && selectedRowIndex < len(m.rows) {
+					row := m.rows[selectedRowIndex]
+					if len(row) >= 7 {
+						var toastCmd tea.Cmd
+						if toggleIgnoreEntry(row[1], row[5]) {
+							m, toastCmd = m.showToast("Hidden " + row[1] + " (ctrl+h shows hidden)")
+						} else {
+							m, toastCmd = m.showToast("Unhidden " + row[1])
+						}
+						m.ignoreList = loadIgnoreList()
+						m = m.refreshAll()
+						return m, toastCmd
+					}
+				}
+			}
+			return m, nil
+		case "ctrl+t":
+			// Toggle the efficiency audit overlay
+			m.auditMode = !m.auditMode
+			return m, nil
+		case "ctrl+h":
+			// Temporarily show ignored rows so they can be unhidden
+			if m.viewMode == "agents" {
+				m.showIgnored = !m.showIgnored
+				m = m.refreshAll()
+				var toastCmd tea.Cmd
+				if m.showIgnored {
+					m, toastCmd = m.showToast(fmt.Sprintf("Showing %d ignored entr(ies)", len(m.ignoreList)))
+				} else {
+					m, toastCmd = m.showToast("Hiding ignored entries")
+				}
+				return m, toastCmd
+			}
+			return m, nil
+		case "w":
+			// Save the editor content as a named clause (sync view only)
+			if m.viewMode == "sync" && m.syncMode != views.EditMode {
+				m.inputMode = true
+				m.textInput = newPromptInput()
+				m.textInput.SetValue(m.syncClauseName)
+				m.inputTarget = "clause-name"
+				return m, nil
+			}
 		case "z":
 			// Register SSH connection - start multi-step input process (agents view only)
 			if m.viewMode == "agents" && m.sshRegistry != nil {
 				// Start SSH connection registration process
 				m.inputMode = true
-				m.inputBuffer = ""
+				m.textInput = newPromptInput()
 				m.inputTarget = "ssh-name"
 				// Clear temp SSH fields
 				m.tempSSHName = ""
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Build metadata injected at release time via ldflags:
+//
+//	-X main.version=... -X main.commit=... -X main.buildDate=...
+var (
+	version   = "dev"
+	commit    = "none"
+	buildDate = "unknown"
+)
+
+// versionString formats the build info shown by --version and the TUI footer
+func versionString() string {
+	return fmt.Sprintf("slay %s (commit %s, built %s)", version, commit, buildDate)
+}
+
+// updateAvailableMsg carries a newer release tag found on GitHub
+type updateAvailableMsg struct {
+	tag string
+}
+
+// checkForUpdateCmd queries the latest GitHub release in the background.
+// Dev builds and any failure stay silent - the check must never get in the
+// way of launching the TUI.
+func checkForUpdateCmd() tea.Msg {
+	if version == "dev" {
+		return nil
+	}
+
+	client := &http.Client{Timeout: 3 * time.Second}
+	resp, err := client.Get("https://api.github.com/repos/WillyV3/slaygent-comms/releases/latest")
+	if err != nil {
+		return nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return nil
+	}
+
+	latest := strings.TrimPrefix(release.TagName, "v")
+	if latest != "" && latest != strings.TrimPrefix(version, "v") {
+		return updateAvailableMsg{tag: release.TagName}
+	}
+	return nil
+}
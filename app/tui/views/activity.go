@@ -0,0 +1,98 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ActivityEvent is one audit log entry ready for display
+type ActivityEvent struct {
+	Time   string
+	Type   string
+	Detail string
+}
+
+// PendingReply is an outstanding reply expectation shown in the Pending panel
+type PendingReply struct {
+	Expecter string
+	Replier  string
+	Deadline string
+	Overdue  bool
+}
+
+// ActivityViewData contains all data needed to render the activity view
+type ActivityViewData struct {
+	Events  []ActivityEvent
+	Pending []PendingReply
+	Width   int
+}
+
+// eventTypeColors gives each event category a distinct color
+var eventTypeColors = map[string]lipgloss.Color{
+	"register":            lipgloss.Color("#00FF00"),
+	"deregister":          lipgloss.Color("#FF6B6B"),
+	"sync":                lipgloss.Color("#00CED1"),
+	"ssh_register":        lipgloss.Color("#87CEEB"),
+	"ssh_remove":          lipgloss.Color("#FFB347"),
+	"message_send":        lipgloss.Color("#5DADE2"),
+	"loop_breaker":        lipgloss.Color("#FF0000"),
+	"policy":              lipgloss.Color("#FF6B6B"),
+	"conversation_delete": lipgloss.Color("#FF0000"),
+}
+
+// RenderActivityView renders the audit log as a scannable activity feed
+func RenderActivityView(data ActivityViewData) string {
+	title := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#87CEEB")).
+		Bold(true).
+		Render(T("activity.title", "📋 Activity"))
+
+	controls := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#888888")).
+		Render(T("activity.controls", "ESC: Back to agents"))
+
+	view := "\n" + title + "    " + controls + "\n\n"
+
+	// Pending panel: replies agents are still waiting on
+	if len(data.Pending) > 0 {
+		pendingTitle := lipgloss.NewStyle().Foreground(lipgloss.Color("#C71585")).Bold(true).Render("⧗ Pending replies")
+		view += pendingTitle + "\n"
+		for _, p := range data.Pending {
+			line := fmt.Sprintf("  %s is waiting on %s (due %s)", p.Expecter, p.Replier, p.Deadline)
+			if p.Overdue {
+				line = lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000")).Render(line + "  OVERDUE")
+			} else {
+				line = lipgloss.NewStyle().Foreground(lipgloss.Color("#D4AC0D")).Render(line)
+			}
+			view += line + "\n"
+		}
+		view += "\n"
+	}
+
+	if len(data.Events) == 0 {
+		view += lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render(T("activity.empty", "No events recorded yet")) + "\n"
+		return wrapToTerminal(view, data.Width)
+	}
+
+	timeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#555555")).Faint(true)
+	detailStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+
+	var lines []string
+	for _, event := range data.Events {
+		typeColor, ok := eventTypeColors[event.Type]
+		if !ok {
+			typeColor = lipgloss.Color("#929292")
+		}
+		styledType := lipgloss.NewStyle().Foreground(typeColor).Render(fmt.Sprintf("%-18s", event.Type))
+
+		lines = append(lines, fmt.Sprintf("%s %s %s",
+			timeStyle.Render("["+event.Time+"]"),
+			styledType,
+			detailStyle.Render(event.Detail)))
+	}
+
+	view += strings.Join(lines, "\n") + "\n"
+	return wrapToTerminal(view, data.Width)
+}
@@ -18,6 +18,7 @@ type SSHConnection struct {
 	Name           string `json:"name"`
 	SSHKey         string `json:"ssh_key"`
 	ConnectCommand string `json:"connect_command"`
+	Resources      string `json:"-"` // Display-ready resource readout ("load ... | mem ... | disk ...")
 }
 
 // AgentsViewData contains all data needed to render the agents view
@@ -35,22 +36,16 @@ type AgentsViewData struct {
 	SyncMessage   string
 	Progress      progress.Model
 	Width         int
+	Heartbeats    map[string]string  // Registered name -> last-seen label from msg --heartbeat
+	Degraded      bool // No local tmux server - table may be empty but the TUI stays usable
 }
 
 // RenderAgentsView renders the agents view
 func RenderAgentsView(data AgentsViewData) string {
-	// ASCII title art with simple 3-color gradient
-	topStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("#87CEEB")) // Light blue
-
-	middleStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("#FF6B6B")) // Coral
-
-	bottomStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("#4ECDC4")) // Turquoise
+	// ASCII title art with simple 3-color gradient (plain bold in no-color mode)
+	topStyle := accentStyle("#87CEEB")    // Light blue
+	middleStyle := accentStyle("#FF6B6B") // Coral
+	bottomStyle := accentStyle("#4ECDC4") // Turquoise
 
 	title := strings.Join([]string{
 		topStyle.Render(" ▄▄ ▝▜                       ▗"),
@@ -66,22 +61,18 @@ func RenderAgentsView(data AgentsViewData) string {
 // SSH Connection Status
 var connectionStatus string
 if data.SSHConnCount > 0 {
-	connectionStatus = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#87CEEB")).
-		Bold(true).
+	connectionStatus = accentStyle("#87CEEB").
 		Render(fmt.Sprintf("🌐 %d SSH machine%s connected", data.SSHConnCount, func() string {
 			if data.SSHConnCount == 1 { return "" }
 			return "s"
 		}()))
 } else {
-	connectionStatus = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888888")).
+	connectionStatus = dimStyle().
 		Render("🌐 No SSH machines connected")
 }
 
 // Controls with grey styling
-controlsStyle := lipgloss.NewStyle().
-	Foreground(lipgloss.Color("#888888")).
+controlsStyle := dimStyle().
 	MarginTop(1)
 
 controls := controlsStyle.Render(strings.Join([]string{
@@ -91,8 +82,12 @@ controls := controlsStyle.Render(strings.Join([]string{
 	"z: Register SSH connection",
 	"x: Manage SSH connections",
 	"r: Refresh agent list",
+	"/: Filter agents",
 	"e: Sync customization (file picker)",
 	"m: View Message History",
+	"v: View Activity Log",
+	"L: View Debug Logs",
+	"D: Run Diagnostics",
 	"?: Learn how to use Slaygent",
 	"q or Ctrl+C: Quit",
 }, "\n"))
@@ -106,11 +101,9 @@ header := lipgloss.JoinHorizontal(
 )
 
 // Table title
-tableTitle := lipgloss.NewStyle().
-	Foreground(lipgloss.Color("#87CEEB")).
-	Bold(true).
+tableTitle := accentStyle("#87CEEB").
 	Align(lipgloss.Center).
-	Render("Use This Page To Register and Unregister AI Coding Tools in TMUX")
+	Render(T("agents.title", "Use This Page To Register and Unregister AI Coding Tools in TMUX"))
 
 // Table subtitle (footer note) - only show when not in input mode
 tableSubtitle := ""
@@ -118,7 +111,7 @@ if !data.InputMode {
 	tableSubtitle = lipgloss.NewStyle().
 		Foreground(lipgloss.Color("#D4AC0D")).
 		Align(lipgloss.Center).
-		Render("* Registering an Agent adds it to the registry and makes it available for inter-agent communication")
+		Render(T("agents.subtitle", "* Registering an Agent adds it to the registry and makes it available for inter-agent communication"))
 }
 
 view := "\n" + header + "\n\n" + tableTitle + "\n\n" + data.Table.View() + "\n\n" + tableSubtitle + "\n"
@@ -151,7 +144,27 @@ if data.InputMode {
 			fullDirectory := row[1]  // Full path for registry
 			displayDirectory := filepath.Base(fullDirectory)  // Short name for display
 			registerText := fmt.Sprintf("Register %s in %s", agentType, displayDirectory)
-			prompt := "\n" + darkPinkStyle.Render(registerText) + fmt.Sprintf("\n\nName: %s_", data.InputBuffer)
+			prompt := "\n" + darkPinkStyle.Render(registerText) + fmt.Sprintf("\n\nName: %s", data.InputBuffer)
+			fullView := view + prompt + "\n\nPress Enter to save, Esc to cancel\n"
+			return wrapToTerminal(fullView, data.Width)
+		}
+
+	case "color":
+		// Custom color prompt for the selected registered agent
+		selectedRow := data.Table.GetHighlightedRowIndex()
+		if selectedRow >= 0 && selectedRow < len(data.Rows) {
+			colorText := fmt.Sprintf("Color for %s (hex like #FF8800, empty to reset)", data.Rows[selectedRow][3])
+			prompt := "\n" + darkPinkStyle.Render(colorText) + fmt.Sprintf("\n\nColor: %s", data.InputBuffer)
+			fullView := view + prompt + "\n\nPress Enter to save, Esc to cancel\n"
+			return wrapToTerminal(fullView, data.Width)
+		}
+
+	case "groups":
+		// Group membership prompt for the selected registered agent
+		selectedRow := data.Table.GetHighlightedRowIndex()
+		if selectedRow >= 0 && selectedRow < len(data.Rows) {
+			groupsText := fmt.Sprintf("Groups for %s (comma-separated, empty to clear)", data.Rows[selectedRow][3])
+			prompt := "\n" + darkPinkStyle.Render(groupsText) + fmt.Sprintf("\n\nGroups: %s", data.InputBuffer)
 			fullView := view + prompt + "\n\nPress Enter to save, Esc to cancel\n"
 			return wrapToTerminal(fullView, data.Width)
 		}
@@ -159,7 +172,7 @@ if data.InputMode {
 	case "ssh-name":
 		// SSH machine name prompt
 		registerText := "Register SSH Connection - Step 1/3"
-		prompt := "\n" + darkPinkStyle.Render(registerText) + fmt.Sprintf("\n\nMachine name: %s_", data.InputBuffer)
+		prompt := "\n" + darkPinkStyle.Render(registerText) + fmt.Sprintf("\n\nMachine name: %s", data.InputBuffer)
 		fullView := view + prompt + "\n\nPress Enter to continue, Esc to cancel\n"
 		return wrapToTerminal(fullView, data.Width)
 
@@ -179,20 +192,21 @@ if data.InputMode {
 			keyFileName := filepath.Base(data.TempSSHKey)
 			keyText = fmt.Sprintf(" (Key: %s)", keyFileName)
 		}
-		prompt := "\n" + darkPinkStyle.Render(registerText + keyText) + fmt.Sprintf("\n\nConnect command: %s_", data.InputBuffer)
+		prompt := "\n" + darkPinkStyle.Render(registerText + keyText) + fmt.Sprintf("\n\nConnect command: %s", data.InputBuffer)
 		fullView := view + prompt + "\n\nPress Enter to save, Esc to cancel\n"
 		return wrapToTerminal(fullView, data.Width)
 	}
 }
 
-// Show error message if no tmux server
-if len(data.Rows) > 0 && data.Rows[0][0] == "ERROR" {
-	view += "\n⚠️  No tmux sessions found. Run 'tmux new' to start.\n"
+// Degraded mode: no local tmux server, but messages, sync, and SSH
+// management don't need one
+if data.Degraded {
+	view += "\n" + T("agents.degraded", "⚠️  No local tmux server - messages (m), sync (s), and SSH machines (z) still work. Run 'tmux new' to manage local agents.") + "\n"
 }
 
 // Show selected row info
 selectedRowIndex := data.Table.GetHighlightedRowIndex()
-if len(data.Rows) > 0 && selectedRowIndex >= 0 && selectedRowIndex < len(data.Rows) && data.Rows[0][0] != "ERROR" {
+if len(data.Rows) > 0 && selectedRowIndex >= 0 && selectedRowIndex < len(data.Rows) {
 	selectedRow := data.Rows[selectedRowIndex]
 	agentType := selectedRow[2]
 	fullDirectory := selectedRow[1]  // data.Rows still has full path
@@ -202,7 +216,11 @@ if len(data.Rows) > 0 && selectedRowIndex >= 0 && selectedRowIndex < len(data.Ro
 	brownStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#8B4513")) // Brown color
 	if data.Registry != nil {
 		if name := data.Registry.GetName(agentType, fullDirectory); name != "" {
-			status = brownStyle.Render(fmt.Sprintf("\nSelected: %s [%s]", selectedRow[3], name))
+			seen := ""
+			if label, ok := data.Heartbeats[name]; ok {
+				seen = " · last seen " + label
+			}
+			status = brownStyle.Render(fmt.Sprintf("\nSelected: %s [%s]%s", selectedRow[3], name, seen))
 		} else {
 			status = brownStyle.Render(fmt.Sprintf("\nSelected: %s (%s)", selectedRow[3], agentType))
 		}
@@ -334,35 +352,87 @@ func BuildTableWithSelection(rows [][]string, selected int, registry interface{
 		Border(lipgloss.ThickBorder())
 }
 
+// pluginColumnKey names the bubble-table column for the j-th plugin column
+func pluginColumnKey(j int) string {
+	return fmt.Sprintf("plugin_%d", j)
+}
+
 // Column keys for bubble-table
 const (
-	columnKeyPane       = "pane"
-	columnKeyDirectory  = "directory"
-	columnKeyAgent      = "agent"
-	columnKeyName       = "name"
-	columnKeyStatus     = "status"
-	columnKeyMachine    = "machine"
-	columnKeyRegistered = "registered"
+	columnKeyPane        = "pane"
+	columnKeyDirectory   = "directory"
+	columnKeyAgent       = "agent"
+	columnKeyName        = "name"
+	columnKeyStatus      = "status"
+	columnKeyMachine     = "machine"
+	columnKeyRegistered  = "registered"
+	columnKeyLastMessage = "last_message"
+	columnKeyTags        = "tags"
 )
 
-// BuildBubbleTable creates a new bubble-table with flex columns and multiline support
-func BuildBubbleTable(rows [][]string, registry interface{ GetName(string, string) string }, width int) table.Model {
-	// Define columns with flex capabilities for better responsive layout
-	columns := []table.Column{
-		table.NewFlexColumn(columnKeyPane, "PANE", 2).WithStyle(
+// defaultColumnOrder is the layout used when no columns are configured
+var defaultColumnOrder = []string{
+	columnKeyPane, columnKeyDirectory, columnKeyAgent, columnKeyName,
+	columnKeyStatus, columnKeyMachine, columnKeyRegistered,
+}
+
+// TableOptions configures the agents table: which columns appear (and in
+// what order), plus the data backing the computed LAST MESSAGE and TAGS
+// columns. The zero value keeps the default layout.
+type TableOptions struct {
+	Columns      []string          // Column keys in display order, nil/empty keeps defaults
+	LastMessages map[string]string // Registered name -> last message label
+	Tags         map[string]string // Registered name -> comma-joined tags
+	Colors       map[string]string // Registered name -> custom hex color override
+	DND          map[string]bool   // Registered name -> currently do-not-disturb
+}
+
+// BuildBubbleTable creates a new bubble-table with flex columns and multiline
+// support. extraColumns adds plugin-provided columns after the built-ins,
+// with cell values taken from the matching row positions past the core seven.
+func BuildBubbleTable(rows [][]string, registry interface{ GetName(string, string) string }, width int, extraColumns []string, opts TableOptions) table.Model {
+	// Define every known column; the configured order picks from these
+	columnSpecs := map[string]table.Column{
+		columnKeyPane: table.NewFlexColumn(columnKeyPane, "PANE", 2).WithStyle(
 			lipgloss.NewStyle().Foreground(lipgloss.Color("#9B59B6")).Align(lipgloss.Center)),
-		table.NewFlexColumn(columnKeyDirectory, "DIRECTORY", 3).WithStyle(
+		columnKeyDirectory: table.NewFlexColumn(columnKeyDirectory, "DIRECTORY", 3).WithStyle(
 			lipgloss.NewStyle().Align(lipgloss.Left)),
-		table.NewColumn(columnKeyAgent, "AGENT", 8).WithStyle(
+		columnKeyAgent: table.NewColumn(columnKeyAgent, "AGENT", 8).WithStyle(
 			lipgloss.NewStyle().Align(lipgloss.Center)),
-		table.NewFlexColumn(columnKeyName, "NAME", 3).WithStyle(
+		columnKeyName: table.NewFlexColumn(columnKeyName, "NAME", 3).WithStyle(
 			lipgloss.NewStyle().Align(lipgloss.Left)),
-		table.NewColumn(columnKeyStatus, "STATUS", 7).WithStyle(
+		columnKeyStatus: table.NewColumn(columnKeyStatus, "STATUS", 7).WithStyle(
+			lipgloss.NewStyle().Align(lipgloss.Center)),
+		columnKeyMachine: table.NewColumn(columnKeyMachine, "MACHINE", 8).WithStyle(
 			lipgloss.NewStyle().Align(lipgloss.Center)),
-		table.NewColumn(columnKeyMachine, "MACHINE", 8).WithStyle(
+		columnKeyRegistered: table.NewColumn(columnKeyRegistered, "REGISTERED?", 12).WithStyle(
 			lipgloss.NewStyle().Align(lipgloss.Center)),
-		table.NewColumn(columnKeyRegistered, "REGISTERED?", 12).WithStyle(
+		columnKeyLastMessage: table.NewFlexColumn(columnKeyLastMessage, "LAST MESSAGE", 3).WithStyle(
 			lipgloss.NewStyle().Align(lipgloss.Center)),
+		columnKeyTags: table.NewFlexColumn(columnKeyTags, "TAGS", 3).WithStyle(
+			lipgloss.NewStyle().Align(lipgloss.Left)),
+	}
+
+	order := defaultColumnOrder
+	if len(opts.Columns) > 0 {
+		order = nil
+		for _, name := range opts.Columns {
+			if _, ok := columnSpecs[name]; ok {
+				order = append(order, name)
+			}
+		}
+		if len(order) == 0 {
+			order = defaultColumnOrder // Nothing valid configured
+		}
+	}
+
+	columns := make([]table.Column, 0, len(order)+len(extraColumns))
+	for _, name := range order {
+		columns = append(columns, columnSpecs[name])
+	}
+	for j, title := range extraColumns {
+		columns = append(columns, table.NewFlexColumn(pluginColumnKey(j), title, 2).WithStyle(
+			lipgloss.NewStyle().Align(lipgloss.Center)))
 	}
 
 	// Agent colors map
@@ -391,56 +461,95 @@ func BuildBubbleTable(rows [][]string, registry interface{ GetName(string, strin
 
 		// Create row data
 		rowData := table.RowData{
-			columnKeyPane:       row[0],
-			columnKeyDirectory:  directory,
-			columnKeyAgent:      row[2],
-			columnKeyName:       row[3],
-			columnKeyStatus:     row[4],
-			columnKeyMachine:    row[5],
-			columnKeyRegistered: row[6],
+			columnKeyPane:        row[0],
+			columnKeyDirectory:   directory,
+			columnKeyAgent:       row[2],
+			columnKeyName:        row[3],
+			columnKeyStatus:      row[4],
+			columnKeyMachine:     row[5],
+			columnKeyRegistered:  row[6],
+			columnKeyLastMessage: opts.LastMessages[row[3]],
+			columnKeyTags:        opts.Tags[row[3]],
 		}
 
 		// Apply agent-specific styling to the AGENT column
-		if agentColor, ok := agentColors[row[2]]; ok {
+		if agentColor, ok := agentColors[row[2]]; ok && !noColorMode {
 			agentCell := table.NewStyledCell(row[2], lipgloss.NewStyle().
 				Foreground(agentColor).Align(lipgloss.Center))
 			rowData[columnKeyAgent] = agentCell
 		}
 
-		// Style registered names in bold blue
+		// Style registered names in bold blue (underline carries the meaning
+		// in no-color mode); a per-agent color override wins so same-type
+		// agents stay distinguishable
 		if len(row) > 6 && row[6] == "✓" {
-			// Override name cell styling for registered agents
-			nameCell := table.NewStyledCell(row[3], lipgloss.NewStyle().
-				Foreground(lipgloss.Color("#5DADE2")).Bold(true))
-			rowData[columnKeyName] = nameCell
+			nameStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#5DADE2")).Bold(true)
+			if custom, ok := opts.Colors[row[3]]; ok {
+				nameStyle = lipgloss.NewStyle().Foreground(lipgloss.Color(custom)).Bold(true)
+			}
+			if noColorMode {
+				nameStyle = lipgloss.NewStyle().Bold(true).Underline(true)
+			}
+			// A moon marks do-not-disturb agents whose deliveries are queued
+			name := row[3]
+			if opts.DND[row[3]] {
+				name += " 🌙"
+			}
+			rowData[columnKeyName] = table.NewStyledCell(name, nameStyle)
 		}
 
 		// Style machine column with distinct colors
-		machineColor := lipgloss.Color("#87CEEB") // Default baby blue for "host"
-		if row[5] != "host" {
-			// Use different color for remote machines
-			machineColor = lipgloss.Color("#FFB347") // Orange for remote machines
+		if !noColorMode {
+			machineColor := lipgloss.Color("#87CEEB") // Default baby blue for "host"
+			if row[5] != "host" {
+				// Use different color for remote machines
+				machineColor = lipgloss.Color("#FFB347") // Orange for remote machines
+			}
+			machineCell := table.NewStyledCell(row[5], lipgloss.NewStyle().
+				Foreground(machineColor).Align(lipgloss.Center))
+			rowData[columnKeyMachine] = machineCell
 		}
-		machineCell := table.NewStyledCell(row[5], lipgloss.NewStyle().
-			Foreground(machineColor).Align(lipgloss.Center))
-		rowData[columnKeyMachine] = machineCell
 
-		// Style registered column with colors and manual centering
+		// Style registered column with colors and manual centering (the ✓/✗
+		// glyphs already carry the meaning in no-color mode)
 		if row[6] == "✓" {
-			regCell := table.NewStyledCell("     ✓     ", lipgloss.NewStyle().
-				Foreground(lipgloss.Color("#00FF00")))
-			rowData[columnKeyRegistered] = regCell
+			regStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00"))
+			if noColorMode {
+				regStyle = lipgloss.NewStyle().Bold(true)
+			}
+			rowData[columnKeyRegistered] = table.NewStyledCell("     ✓     ", regStyle)
 		} else if row[6] == "✗" {
-			regCell := table.NewStyledCell("     ✗     ", lipgloss.NewStyle().
-				Foreground(lipgloss.Color("#FF0000")))
-			rowData[columnKeyRegistered] = regCell
+			regStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+			if noColorMode {
+				regStyle = lipgloss.NewStyle()
+			}
+			rowData[columnKeyRegistered] = table.NewStyledCell("     ✗     ", regStyle)
+		}
+
+		// Stale remote agents (registry entry with no live pane in that
+		// directory) show their status in red
+		if row[4] == "stale" && !noColorMode {
+			rowData[columnKeyStatus] = table.NewStyledCell(row[4], lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#FF6B6B")).Align(lipgloss.Center))
 		}
 
-		// Generate unique directory colors
-		colorNum := 21 + (i * 30) % 210
-		dirCell := table.NewStyledCell(directory, lipgloss.NewStyle().
-			Foreground(lipgloss.Color(fmt.Sprintf("%d", colorNum))))
-		rowData[columnKeyDirectory] = dirCell
+		// Plugin-provided cells follow the core seven columns
+		for j := range extraColumns {
+			value := ""
+			if len(row) > 7+j {
+				value = row[7+j]
+			}
+			rowData[pluginColumnKey(j)] = value
+		}
+
+		// Generate unique directory colors (skipped in no-color mode, where
+		// the rainbow palette is the worst offender)
+		if !noColorMode {
+			colorNum := 21 + (i * 30) % 210
+			dirCell := table.NewStyledCell(directory, lipgloss.NewStyle().
+				Foreground(lipgloss.Color(fmt.Sprintf("%d", colorNum))))
+			rowData[columnKeyDirectory] = dirCell
+		}
 
 		// Create final table row with all styled cells
 		tableRow := table.NewRow(rowData)
@@ -448,11 +557,17 @@ func BuildBubbleTable(rows [][]string, registry interface{ GetName(string, strin
 	}
 
 	// Create and configure the table with flex and multiline support
+	highlightStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#87CEEB")).
+		Background(lipgloss.Color("#1E3A5F")).
+		Bold(true)
+	if noColorMode {
+		highlightStyle = lipgloss.NewStyle().Bold(true).Reverse(true)
+	}
+
 	bubbleTable := table.New(columns).
 		WithRows(tableRows).
-		HeaderStyle(lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#87CEEB")).
-			Bold(true).
+		HeaderStyle(accentStyle("#87CEEB").
 			Align(lipgloss.Center)).
 		SelectableRows(false).
 		Focused(true).
@@ -460,10 +575,7 @@ func BuildBubbleTable(rows [][]string, registry interface{ GetName(string, strin
 		WithTargetWidth(width).
 		WithBaseStyle(lipgloss.NewStyle().
 			BorderForeground(lipgloss.Color("#006666"))).
-		HighlightStyle(lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#87CEEB")).
-			Background(lipgloss.Color("#1E3A5F")).
-			Bold(true))
+		HighlightStyle(highlightStyle)
 
 	return bubbleTable
 }
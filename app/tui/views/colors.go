@@ -0,0 +1,30 @@
+package views
+
+import (
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Accessibility: NO_COLOR (or SLAY_NO_COLOR) disables the heavy color
+// styling - per-directory rainbow colors, per-agent hues, faint grey text -
+// and falls back to bold/underline semantics for colorblind users and
+// terminals with limited palettes.
+var noColorMode = os.Getenv("NO_COLOR") != "" || os.Getenv("SLAY_NO_COLOR") != ""
+
+// accentStyle is bold color normally, plain bold in no-color mode
+func accentStyle(color string) lipgloss.Style {
+	if noColorMode {
+		return lipgloss.NewStyle().Bold(true)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Bold(true)
+}
+
+// dimStyle is faint grey normally, unstyled in no-color mode so the text
+// stays readable on limited palettes
+func dimStyle() lipgloss.Style {
+	if noColorMode {
+		return lipgloss.NewStyle()
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
+}
@@ -0,0 +1,105 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// CompareMessage is one message prepared for the compare view
+type CompareMessage struct {
+	Sender string
+	Body   string
+	SentAt time.Time
+}
+
+// CompareViewData contains all data needed to render the compare view
+type CompareViewData struct {
+	LeftTitle  string
+	RightTitle string
+	Left       []CompareMessage
+	Right      []CompareMessage
+	Width      int
+}
+
+// RenderCompareView renders two conversations side by side on a single
+// merged timeline, so the same task delegated to two agents can be compared
+// turn by turn
+func RenderCompareView(data CompareViewData) string {
+	title := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#87CEEB")).
+		Bold(true).
+		Render(T("compare.title", "⚖ Conversation Compare"))
+
+	controls := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#888888")).
+		Render(T("compare.controls", "ESC: back to messages"))
+
+	view := "\n" + title + "    " + controls + "\n\n"
+
+	colWidth := (data.Width - 3) / 2
+	if colWidth < 20 {
+		colWidth = 20
+	}
+
+	headerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#D4AC0D")).Bold(true)
+	view += headerStyle.Render(padCompareCell(data.LeftTitle, colWidth)) + " │ " +
+		headerStyle.Render(data.RightTitle) + "\n"
+	view += strings.Repeat("─", colWidth) + "─┼─" + strings.Repeat("─", colWidth) + "\n"
+
+	if len(data.Left) == 0 && len(data.Right) == 0 {
+		view += lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render(T("compare.empty", "Nothing to compare")) + "\n"
+		return wrapToTerminal(view, data.Width)
+	}
+
+	timeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#555555")).Faint(true)
+	senderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#87CEEB"))
+
+	// Merge both conversations into one chronological sequence; each row
+	// fills its own column and leaves the other blank
+	li, ri := 0, 0
+	for li < len(data.Left) || ri < len(data.Right) {
+		takeLeft := ri >= len(data.Right) ||
+			(li < len(data.Left) && !data.Left[li].SentAt.After(data.Right[ri].SentAt))
+
+		var msg CompareMessage
+		if takeLeft {
+			msg = data.Left[li]
+			li++
+		} else {
+			msg = data.Right[ri]
+			ri++
+		}
+
+		prefix := fmt.Sprintf("[%s] %s: ", msg.SentAt.Format("15:04"), msg.Sender)
+		body := strings.ReplaceAll(msg.Body, "\n", " ")
+		if max := colWidth - len(prefix); max > 3 && len(body) > max {
+			body = body[:max-3] + "..."
+		}
+		cell := timeStyle.Render("["+msg.SentAt.Format("15:04")+"] ") +
+			senderStyle.Render(msg.Sender+": ") + body
+		plainLen := len(prefix) + len(body)
+
+		if takeLeft {
+			pad := colWidth - plainLen
+			if pad < 0 {
+				pad = 0
+			}
+			view += cell + strings.Repeat(" ", pad) + " │\n"
+		} else {
+			view += strings.Repeat(" ", colWidth) + " │ " + cell + "\n"
+		}
+	}
+
+	return wrapToTerminal(view, data.Width)
+}
+
+// padCompareCell pads plain text to a column width, truncating when needed
+func padCompareCell(text string, width int) string {
+	if len(text) > width {
+		return text[:width]
+	}
+	return text + strings.Repeat(" ", width-len(text))
+}
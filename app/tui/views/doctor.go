@@ -0,0 +1,53 @@
+package views
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DiagCheck is one dependency check result for the diagnostics view
+type DiagCheck struct {
+	Name   string `json:"name"`
+	OK     bool   `json:"ok"`
+	Detail string `json:"detail"`
+	Fix    string `json:"fix,omitempty"` // Copy-pasteable fix command, empty when OK
+}
+
+// DoctorViewData contains all data needed to render the diagnostics view
+type DoctorViewData struct {
+	Checks []DiagCheck
+	Width  int
+}
+
+// RenderDoctorView renders dependency checks with fix commands for failures
+func RenderDoctorView(data DoctorViewData) string {
+	title := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#87CEEB")).
+		Bold(true).
+		Render("🩺 Diagnostics")
+
+	controls := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#888888")).
+		Render("ESC: Back to agents")
+
+	view := "\n" + title + "    " + controls + "\n\n"
+
+	okStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00"))
+	failStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF6B6B"))
+	detailStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
+	fixStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#D4AC0D"))
+	nameStyle := lipgloss.NewStyle().Bold(true).Width(14)
+
+	for _, check := range data.Checks {
+		marker := okStyle.Render("✓")
+		if !check.OK {
+			marker = failStyle.Render("✗")
+		}
+
+		view += "  " + marker + " " + nameStyle.Render(check.Name) + " " + detailStyle.Render(check.Detail) + "\n"
+		if !check.OK && check.Fix != "" {
+			view += "      " + fixStyle.Render("fix: "+check.Fix) + "\n"
+		}
+	}
+
+	return wrapToTerminal(view, data.Width)
+}
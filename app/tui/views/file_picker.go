@@ -16,10 +16,22 @@ type DiscoveredFile struct {
 	Type      string
 	Directory string
 	Selected  bool
+	Missing   bool // Doesn't exist yet; sync will create it
+}
+
+// PickerRow is one visible line in the grouped file picker: a project
+// header (with group selection counts) or a file beneath it
+type PickerRow struct {
+	Header    bool
+	Project   string
+	Collapsed bool
+	Selected  int // Selected files in the group (headers only)
+	Total     int // Files in the group (headers only)
+	File      DiscoveredFile
 }
 
 // RenderFilePicker renders the file picker interface
-func RenderFilePicker(files []DiscoveredFile, selectedIndex int, loading bool, errorMsg string, spinners []spinner.Model, width, height int) string {
+func RenderFilePicker(rows []PickerRow, selectedIndex int, loading bool, errorMsg string, spinners []spinner.Model, width, height int) string {
 	if loading {
 		return renderFilePickerLoading(spinners, width, height)
 	}
@@ -28,11 +40,11 @@ func RenderFilePicker(files []DiscoveredFile, selectedIndex int, loading bool, e
 		return renderFilePickerError(errorMsg, width, height)
 	}
 
-	if len(files) == 0 {
+	if len(rows) == 0 {
 		return renderFilePickerEmpty(width, height)
 	}
 
-	return renderFilePickerList(files, selectedIndex, width, height)
+	return renderFilePickerList(rows, selectedIndex, width, height)
 }
 
 // renderFilePickerLoading shows loading state while discovering files
@@ -115,7 +127,7 @@ func renderFilePickerEmpty(width, height int) string {
 }
 
 // renderFilePickerList renders the main file picker list
-func renderFilePickerList(files []DiscoveredFile, selectedIndex int, width, height int) string {
+func renderFilePickerList(rows []PickerRow, selectedIndex int, width, height int) string {
 	// Ensure minimum dimensions
 	if width < 20 {
 		width = 20
@@ -127,17 +139,23 @@ func renderFilePickerList(files []DiscoveredFile, selectedIndex int, width, heig
 	// Calculate available width for content (border + padding)
 	contentWidth := width - 4 // 2 for border, 2 for padding
 
-	// Header
-	selectedCount := getSelectedCount(files)
-	header := truncateText(fmt.Sprintf("Custom Sync - File Selection (Selected: %d/%d files)", selectedCount, len(files)), contentWidth)
+	// Header - group headers carry the selection counts
+	selectedCount, totalCount := 0, 0
+	for _, row := range rows {
+		if row.Header {
+			selectedCount += row.Selected
+			totalCount += row.Total
+		}
+	}
+	header := truncateText(fmt.Sprintf("Custom Sync - File Selection (Selected: %d/%d files)", selectedCount, totalCount), contentWidth)
 
 	// Footer with instructions (responsive to width)
 	var footer string
 	if contentWidth >= 76 {
-		footer = "[SPACE] Toggle • [A] Select All • [N] Select None • [F] Current Project\n" +
-			"[ENTER] Sync Selected • [ESC] Cancel"
+		footer = "[SPACE] Toggle File/Group • [←/→] Collapse/Expand • [A] Select All • [N] None\n" +
+			"[F] Current Project • [I] Show Ignored • [ENTER] Sync Selected • [ESC] Cancel"
 	} else if contentWidth >= 46 {
-		footer = "[SPC] Toggle • [A] All • [N] None • [F] Project\n" +
+		footer = "[SPC] Toggle • [←/→] Fold • [A] All • [N] None\n" +
 			"[ENTER] Sync • [ESC] Cancel"
 	} else {
 		footer = "[SPC] Toggle • [A] All • [N] None\n" +
@@ -155,17 +173,22 @@ func renderFilePickerList(files []DiscoveredFile, selectedIndex int, width, heig
 		listHeight = 1
 	}
 
-	// File list
+	// Row list: project headers with their files beneath
 	var fileLines []string
-	if len(files) == 0 {
+	if len(rows) == 0 {
 		fileLines = []string{"No files to display"}
 	} else {
 		// Calculate visible range for scrolling
-		startIdx, endIdx := calculateVisibleRange(selectedIndex, len(files), listHeight)
-
-		for i := startIdx; i < endIdx && i < len(files); i++ {
-			file := files[i]
-			line := renderFileListItem(file, i == selectedIndex, contentWidth)
+		startIdx, endIdx := calculateVisibleRange(selectedIndex, len(rows), listHeight)
+
+		for i := startIdx; i < endIdx && i < len(rows); i++ {
+			row := rows[i]
+			var line string
+			if row.Header {
+				line = renderGroupHeader(row, i == selectedIndex, contentWidth)
+			} else {
+				line = renderFileListItem(row.File, i == selectedIndex, contentWidth)
+			}
 			fileLines = append(fileLines, line)
 		}
 
@@ -190,6 +213,31 @@ func renderFilePickerList(files []DiscoveredFile, selectedIndex int, width, heig
 		Render(content)
 }
 
+// renderGroupHeader renders one project header row with its fold marker
+// and selection count
+func renderGroupHeader(row PickerRow, isSelected bool, maxWidth int) string {
+	if maxWidth < 10 {
+		maxWidth = 10
+	}
+
+	marker := "▾"
+	if row.Collapsed {
+		marker = "▸"
+	}
+	text := truncateText(fmt.Sprintf("%s %s (%d/%d)", marker, makeDisplayPath(row.Project), row.Selected, row.Total), maxWidth)
+	if len(text) < maxWidth {
+		text += strings.Repeat(" ", maxWidth-len(text))
+	}
+
+	style := lipgloss.NewStyle().Width(maxWidth).Bold(true).Foreground(lipgloss.Color("#87CEEB"))
+	if isSelected {
+		style = style.Background(lipgloss.Color("62")).Foreground(lipgloss.Color("230"))
+	} else if row.Selected == row.Total && row.Total > 0 {
+		style = style.Foreground(lipgloss.Color("34"))
+	}
+	return style.Render(text)
+}
+
 // renderFileListItem renders a single file item in the list
 func renderFileListItem(file DiscoveredFile, isSelected bool, maxWidth int) string {
 	// Ensure minimum width
@@ -207,7 +255,11 @@ func renderFileListItem(file DiscoveredFile, isSelected bool, maxWidth int) stri
 	pathSpace := maxWidth - 5 // " [ ] " = 5 chars
 
 	// Convert to user-friendly display path and truncate
-	displayPath := truncateText(makeDisplayPath(file.Path), pathSpace)
+	displayPath := makeDisplayPath(file.Path)
+	if file.Missing {
+		displayPath += " (new)"
+	}
+	displayPath = truncateText(displayPath, pathSpace)
 
 	// Create the line
 	line := fmt.Sprintf(" %s %s", checkbox, displayPath)
@@ -255,26 +307,9 @@ func calculateVisibleRange(selectedIndex, totalFiles, visibleCount int) (int, in
 	return start, end
 }
 
-// truncateText truncates text to fit within maxWidth
+// truncateText truncates text to fit within maxWidth terminal cells
 func truncateText(text string, maxWidth int) string {
-	if len(text) <= maxWidth {
-		return text
-	}
-	if maxWidth <= 3 {
-		return "..."
-	}
-	return text[:maxWidth-3] + "..."
-}
-
-// getSelectedCount counts how many files are selected
-func getSelectedCount(files []DiscoveredFile) int {
-	count := 0
-	for _, file := range files {
-		if file.Selected {
-			count++
-		}
-	}
-	return count
+	return truncateWidth(text, maxWidth)
 }
 
 // makeDisplayPath converts absolute paths to user-friendly display paths
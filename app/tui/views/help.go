@@ -3,6 +3,8 @@ package views
 import (
 	"embed"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/glamour"
@@ -38,12 +40,24 @@ type HelpTab struct {
 	Content string
 }
 
+// helpMatch locates one search hit within the rendered help content
+type helpMatch struct {
+	tab  int
+	line int
+}
+
 type HelpModel struct {
 	viewport    viewport.Model
 	tabs        []HelpTab
 	activeTab   int
 	width       int
 	height      int
+
+	// '/' search across all tabs
+	searchMode  bool
+	searchQuery string
+	matches     []helpMatch
+	matchIdx    int
 }
 
 func NewHelpModel(width, height int) (*HelpModel, error) {
@@ -70,11 +84,15 @@ func NewHelpModel(width, height int) (*HelpModel, error) {
 		return nil, fmt.Errorf("failed to create glamour renderer: %w", err)
 	}
 
-	// Load content for all tabs
+	// Load content for all tabs, preferring locale overrides when present
 	for i := range tabs {
-		content, err := helpFS.ReadFile(tabs[i].File)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read %s: %w", tabs[i].File, err)
+		content, ok := localeHelpDoc(tabs[i].File)
+		if !ok {
+			var err error
+			content, err = helpFS.ReadFile(tabs[i].File)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", tabs[i].File, err)
+			}
 		}
 
 		// Render markdown content
@@ -139,6 +157,89 @@ func (m *HelpModel) PrevTab() {
 	}
 }
 
+// ansiEscapes matches terminal styling sequences so search sees plain text
+var ansiEscapes = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// StartSearch enters search input mode
+func (m *HelpModel) StartSearch() {
+	m.searchMode = true
+	m.searchQuery = ""
+	m.matches = nil
+	m.matchIdx = 0
+}
+
+// SearchActive reports whether the search prompt is capturing keystrokes
+func (m *HelpModel) SearchActive() bool {
+	return m.searchMode
+}
+
+// HandleSearchKey consumes one keystroke while the search prompt is open
+func (m *HelpModel) HandleSearchKey(key string) {
+	switch key {
+	case "esc":
+		m.searchMode = false
+		m.searchQuery = ""
+		m.matches = nil
+	case "enter":
+		m.searchMode = false
+		m.runSearch()
+		if len(m.matches) > 0 {
+			m.jumpToMatch(0)
+		}
+	case "backspace":
+		if len(m.searchQuery) > 0 {
+			m.searchQuery = m.searchQuery[:len(m.searchQuery)-1]
+		}
+	default:
+		if len([]rune(key)) == 1 {
+			m.searchQuery += key
+		}
+	}
+}
+
+// NextMatch jumps to the following search hit, wrapping across tabs
+func (m *HelpModel) NextMatch() {
+	if len(m.matches) == 0 {
+		return
+	}
+	m.jumpToMatch((m.matchIdx + 1) % len(m.matches))
+}
+
+// runSearch scans every tab's rendered content for the query
+func (m *HelpModel) runSearch() {
+	m.matches = nil
+	query := strings.ToLower(m.searchQuery)
+	if query == "" {
+		return
+	}
+
+	for tabIdx, tab := range m.tabs {
+		for lineIdx, line := range strings.Split(tab.Content, "\n") {
+			plain := strings.ToLower(ansiEscapes.ReplaceAllString(line, ""))
+			if strings.Contains(plain, query) {
+				m.matches = append(m.matches, helpMatch{tab: tabIdx, line: lineIdx})
+			}
+		}
+	}
+}
+
+// jumpToMatch switches to the match's tab and scrolls it into view
+func (m *HelpModel) jumpToMatch(idx int) {
+	m.matchIdx = idx
+	match := m.matches[idx]
+
+	if match.tab != m.activeTab {
+		m.activeTab = match.tab
+		m.viewport.SetContent(m.tabs[m.activeTab].Content)
+	}
+
+	offset := match.line - m.viewport.Height/2
+	if offset < 0 {
+		offset = 0
+	}
+	m.viewport.SetYOffset(offset)
+}
+
 func (m *HelpModel) renderTabs() string {
 	var tabs []string
 	for i, tab := range m.tabs {
@@ -163,5 +264,15 @@ func (m *HelpModel) View() string {
 }
 
 func (m *HelpModel) helpFooter() string {
-	return helpStyle.Render("\n  ↑/↓: Navigate • ←/→: Switch tabs • q/Esc: Back to agents view\n")
+	if m.searchMode {
+		return helpStyle.Render("\n  Search: ") + m.searchQuery + helpStyle.Render("_  (Enter: find • Esc: cancel)\n")
+	}
+	if len(m.matches) > 0 {
+		return helpStyle.Render(fmt.Sprintf("\n  Match %d/%d for %q • n: next match • /: new search • q/Esc: Back\n",
+			m.matchIdx+1, len(m.matches), m.searchQuery))
+	}
+	if m.searchQuery != "" {
+		return helpStyle.Render(fmt.Sprintf("\n  No matches for %q • /: new search • q/Esc: Back\n", m.searchQuery))
+	}
+	return helpStyle.Render("\n  " + T("help.footer", "↑/↓: Navigate • ←/→: Switch tabs • /: Search • q/Esc: Back to agents view") + "\n")
 }
@@ -0,0 +1,67 @@
+package views
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// Locale support: user-facing strings pass through T() so a message
+// catalogue can replace the built-in English without patching source. A
+// catalogue is a flat JSON object (key -> translated string) at
+// ~/.slaygent/locale/<locale>.json; help docs can be overridden per file at
+// ~/.slaygent/locale/<locale>/help-docs/<name>.md. Missing catalogues and
+// missing keys silently fall back to English.
+
+var (
+	localeCatalogue map[string]string
+	localeDocDir    string // Per-locale dir holding help-doc overrides
+)
+
+// LoadLocale reads the message catalogue for the configured locale. An empty
+// locale (the default) keeps the built-in English strings.
+func LoadLocale(locale string) {
+	localeCatalogue = nil
+	localeDocDir = ""
+	if locale == "" {
+		return
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return
+	}
+	localeDir := filepath.Join(home, ".slaygent", "locale")
+	localeDocDir = filepath.Join(localeDir, locale)
+
+	data, err := os.ReadFile(filepath.Join(localeDir, locale+".json"))
+	if err != nil {
+		return
+	}
+	var catalogue map[string]string
+	if err := json.Unmarshal(data, &catalogue); err != nil {
+		return
+	}
+	localeCatalogue = catalogue
+}
+
+// T returns the localized string for key, or the built-in English fallback
+func T(key, fallback string) string {
+	if s, ok := localeCatalogue[key]; ok && s != "" {
+		return s
+	}
+	return fallback
+}
+
+// localeHelpDoc returns a locale override for an embedded help doc, if one
+// exists (file is the embed path, e.g. "help-docs/overview.md")
+func localeHelpDoc(file string) ([]byte, bool) {
+	if localeDocDir == "" {
+		return nil, false
+	}
+	data, err := os.ReadFile(filepath.Join(localeDocDir, filepath.FromSlash(file)))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
@@ -0,0 +1,51 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LogsViewData contains all data needed to render the debug logs view
+type LogsViewData struct {
+	Lines []string
+	Width int
+}
+
+// RenderLogsView renders the tail of the internal debug log
+func RenderLogsView(data LogsViewData) string {
+	title := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#87CEEB")).
+		Bold(true).
+		Render("🪵 Logs (~/.slaygent/logs/slay.log)")
+
+	controls := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#888888")).
+		Render("ESC: Back to agents")
+
+	view := "\n" + title + "    " + controls + "\n\n"
+
+	if len(data.Lines) == 0 {
+		view += lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render("No log entries") + "\n"
+		return wrapToTerminal(view, data.Width)
+	}
+
+	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF6B6B"))
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#D4AC0D"))
+	infoStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+
+	var styled []string
+	for _, line := range data.Lines {
+		switch {
+		case strings.Contains(line, "[ERROR]"):
+			styled = append(styled, errorStyle.Render(line))
+		case strings.Contains(line, "[WARN]"):
+			styled = append(styled, warnStyle.Render(line))
+		default:
+			styled = append(styled, infoStyle.Render(line))
+		}
+	}
+
+	view += strings.Join(styled, "\n") + "\n"
+	return wrapToTerminal(view, data.Width)
+}
@@ -56,10 +56,10 @@ func RenderMessagesView(data MessagesViewData) string {
 	panelHeight := data.Height - 8
 
 	// Simple header
-	title := messagesTitleStyle.Render("MESSAGE HISTORY")
+	title := messagesTitleStyle.Render(T("messages.title", "MESSAGE HISTORY"))
 
 	// Simple controls
-	controls := messagesControlsStyle.Render("↑/↓: navigate • ←/→: switch panels • d: delete • ESC: back")
+	controls := messagesControlsStyle.Render(T("messages.controls", "↑/↓: navigate • ←/→: panels • ctrl+k: switch • 1-9: quick reply • T: template • c: compare • l: live • g: markdown • N: note • e: export • d: delete • ESC: back"))
 
 	// Build panels
 	leftPanel := renderConversationsPanel(data, leftWidth, panelHeight)
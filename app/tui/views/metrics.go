@@ -0,0 +1,71 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// AgentMetrics is one agent's traffic summary over the measured window
+type AgentMetrics struct {
+	Name        string
+	Sent        int     // Messages sent in the window
+	Received    int     // Messages received in the window
+	OutPerHour  float64 // Send rate
+	InPerHour   float64 // Receive rate
+	AvgReply    string  // Average time to answer an incoming message, "-" when unknown
+	FailureRate float64 // Fraction of sent messages that failed delivery
+}
+
+// MetricsViewData contains all data needed to render the metrics view
+type MetricsViewData struct {
+	Agents []AgentMetrics
+	Window string // Human label for the measured window, e.g. "last 24h"
+	Width  int
+}
+
+// RenderMetricsView renders per-agent message rates, reply latency, and
+// failure rates as an aligned table
+func RenderMetricsView(data MetricsViewData) string {
+	title := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#87CEEB")).
+		Bold(true).
+		Render(T("metrics.title", "📊 Agent Metrics") + " (" + data.Window + ")")
+
+	controls := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#888888")).
+		Render(T("metrics.controls", "ESC: Back to agents"))
+
+	view := "\n" + title + "    " + controls + "\n\n"
+
+	if len(data.Agents) == 0 {
+		view += lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render(T("metrics.empty", "No message traffic in the window")) + "\n"
+		return wrapToTerminal(view, data.Width)
+	}
+
+	headerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#C71585")).Bold(true)
+	view += headerStyle.Render(fmt.Sprintf("%-16s %8s %8s %9s %9s %10s %8s",
+		"AGENT", "SENT", "RECV", "OUT/HR", "IN/HR", "AVG REPLY", "FAIL")) + "\n"
+
+	nameStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#87CEEB"))
+	rowStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+	failStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF6B6B"))
+
+	var lines []string
+	for _, agent := range data.Agents {
+		fail := fmt.Sprintf("%7.0f%%", agent.FailureRate*100)
+		if agent.FailureRate > 0 {
+			fail = failStyle.Render(fail)
+		} else {
+			fail = rowStyle.Render(fail)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s",
+			nameStyle.Render(fmt.Sprintf("%-16s", agent.Name)),
+			rowStyle.Render(fmt.Sprintf("%8d %8d %9.1f %9.1f %10s", agent.Sent, agent.Received, agent.OutPerHour, agent.InPerHour, agent.AvgReply)),
+			fail))
+	}
+
+	view += strings.Join(lines, "\n") + "\n"
+	return wrapToTerminal(view, data.Width)
+}
@@ -0,0 +1,49 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// PluginSection is one plugin's rendered view output
+type PluginSection struct {
+	Name   string
+	Output string
+}
+
+// PluginsViewData contains all data needed to render the plugins view
+type PluginsViewData struct {
+	Sections []PluginSection
+	Width    int
+}
+
+// RenderPluginsView stacks the output of every view-capable plugin
+func RenderPluginsView(data PluginsViewData) string {
+	title := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#87CEEB")).
+		Bold(true).
+		Render("🔌 Plugins")
+
+	controls := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#888888")).
+		Render("ESC: Back to agents")
+
+	view := "\n" + title + "    " + controls + "\n\n"
+
+	if len(data.Sections) == 0 {
+		view += lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).
+			Render("No view-capable plugins installed (~/.slaygent/plugins)") + "\n"
+		return wrapToTerminal(view, data.Width)
+	}
+
+	headerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#C71585")).Bold(true)
+
+	var sections []string
+	for _, section := range data.Sections {
+		sections = append(sections, headerStyle.Render("── "+section.Name+" ──")+"\n"+section.Output)
+	}
+
+	view += strings.Join(sections, "\n\n") + "\n"
+	return wrapToTerminal(view, data.Width)
+}
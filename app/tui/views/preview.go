@@ -0,0 +1,51 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// PreviewViewData contains all data needed to render the pane preview view
+type PreviewViewData struct {
+	Title   string
+	Content string
+	Width   int
+	Height  int
+}
+
+// RenderPreviewView renders a captured pane's content for one agent
+func RenderPreviewView(data PreviewViewData) string {
+	title := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#87CEEB")).
+		Bold(true).
+		Render("👁 Pane Preview: " + data.Title)
+
+	controls := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#888888")).
+		Render("ESC: Back to agents")
+
+	view := "\n" + title + "    " + controls + "\n\n"
+
+	content := data.Content
+	if strings.TrimSpace(content) == "" {
+		content = lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render("(pane is empty)")
+	}
+
+	// Keep the tail that fits the terminal, the most recent output matters
+	lines := strings.Split(content, "\n")
+	maxLines := data.Height - 6
+	if maxLines > 0 && len(lines) > maxLines {
+		lines = lines[len(lines)-maxLines:]
+	}
+
+	panel := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("#006666")).
+		Padding(0, 1).
+		Width(data.Width - 6).
+		Render(strings.Join(lines, "\n"))
+
+	view += panel + "\n"
+	return wrapToTerminal(view, data.Width)
+}
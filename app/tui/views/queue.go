@@ -0,0 +1,84 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// QueueItem is one stuck delivery ready for display
+type QueueItem struct {
+	ID       int
+	Sender   string
+	Receiver string
+	Body     string
+	Time     string
+	Status   string // "failed" or "detached"
+}
+
+// QueueViewData contains all data needed to render the queue view
+type QueueViewData struct {
+	Items    []QueueItem
+	Selected int
+	Width    int
+}
+
+var queueStatusColors = map[string]lipgloss.Color{
+	"failed":   lipgloss.Color("#FF6B6B"),
+	"detached": lipgloss.Color("#D4AC0D"),
+	"dnd":      lipgloss.Color("#9B59B6"),
+}
+
+// RenderQueueView renders failed and detached deliveries as a manageable list
+func RenderQueueView(data QueueViewData) string {
+	title := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#87CEEB")).
+		Bold(true).
+		Render(T("queue.title", "📮 Message Queue"))
+
+	controls := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#888888")).
+		Render(T("queue.controls", "↑/↓: navigate • r: retry • e: edit • d: drop • ESC: back"))
+
+	view := "\n" + title + "    " + controls + "\n\n"
+
+	if len(data.Items) == 0 {
+		view += lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render(T("queue.empty", "No stuck deliveries")) + "\n"
+		return wrapToTerminal(view, data.Width)
+	}
+
+	timeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#555555")).Faint(true)
+	routeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#87CEEB"))
+	bodyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+
+	var lines []string
+	for i, item := range data.Items {
+		statusColor, ok := queueStatusColors[item.Status]
+		if !ok {
+			statusColor = lipgloss.Color("#929292")
+		}
+		styledStatus := lipgloss.NewStyle().Foreground(statusColor).Render(fmt.Sprintf("%-8s", item.Status))
+
+		body := item.Body
+		if maxBody := data.Width - 50; maxBody > 10 && len(body) > maxBody {
+			body = body[:maxBody-3] + "..."
+		}
+
+		line := fmt.Sprintf("%s %s %s %s",
+			timeStyle.Render("["+item.Time+"]"),
+			styledStatus,
+			routeStyle.Render(item.Sender+" → "+item.Receiver),
+			bodyStyle.Render(body))
+
+		if i == data.Selected {
+			line = "> " + lipgloss.NewStyle().Bold(true).Render(line)
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+
+	view += strings.Join(lines, "\n") + "\n"
+	return wrapToTerminal(view, data.Width)
+}
@@ -80,11 +80,8 @@ func renderConnectionsList(data SSHConnectionsViewData) string {
 			keyName = "No key specified"
 		}
 
-		// Truncate long commands for display
-		command := conn.ConnectCommand
-		if len(command) > 50 {
-			command = command[:47] + "..."
-		}
+		// Truncate long commands for display (width-aware for unicode)
+		command := truncateWidth(conn.ConnectCommand, 50)
 
 		line := fmt.Sprintf("%-20s │ %-20s │ %s",
 			conn.Name,
@@ -100,6 +97,13 @@ func renderConnectionsList(data SSHConnectionsViewData) string {
 		}
 
 		lines = append(lines, line)
+
+		// Resource readout underneath, once the background fetch lands
+		resources := conn.Resources
+		if resources == "" {
+			resources = "fetching resources..."
+		}
+		lines = append(lines, sshControlsStyle.Render("      "+resources))
 	}
 
 	// Add header
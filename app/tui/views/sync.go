@@ -15,6 +15,10 @@ type SyncViewData struct {
 	// Text editing
 	Editor       textarea.Model
 	Modified     bool
+	Preview      string // Clause with template variables expanded (PreviewMode)
+	ClauseName   string // Name of the loaded named clause ("" = unsaved)
+	InputMode    bool   // Prompting for a clause name to save under
+	InputBuffer  string // Rendered text input for the prompt
 
 	// State management
 	Mode         SyncMode
@@ -35,7 +39,9 @@ const (
 	PreviewMode
 )
 
-// Default registry clause template - content only (markers added by script)
+// Default registry clause template - content only (markers added on write).
+// {roster} expands to the live agent roster at sync time, so every synced
+// CLAUDE.md lists who is registered right now.
 const DefaultRegistryClause = `# Inter-Agent Communication
 @~/.slaygent/registry.json
 
@@ -45,7 +51,7 @@ Example: ` + "`msg backend-dev \"Please update the API endpoint\"`" + `
 IMPORTANT: When responding to messages, always use the --from flag:
 ` + "`msg --from <your_agent_name> <target_agent> \"<response>\"`" + `
 This ensures proper conversation logging and tracking.
-
+{roster}
 <!-- Registry automatically synced by slaygent-manager -->`
 
 // KeyMap defines the key bindings for the sync view
@@ -53,6 +59,7 @@ type SyncKeyMap struct {
 	Enter     key.Binding
 	Escape    key.Binding
 	Save      key.Binding
+	Preview   key.Binding
 	Help      key.Binding
 }
 
@@ -70,6 +77,10 @@ func NewSyncKeyMap() SyncKeyMap {
 			key.WithKeys("c"),
 			key.WithHelp("c", "custom sync (exit edit first)"),
 		),
+		Preview: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "preview as written"),
+		),
 		Help: key.NewBinding(
 			key.WithKeys("?"),
 			key.WithHelp("?", "toggle help"),
@@ -79,13 +90,13 @@ func NewSyncKeyMap() SyncKeyMap {
 
 // ShortHelp returns key bindings for the short help view
 func (k SyncKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Save, k.Escape}
+	return []key.Binding{k.Save, k.Preview, k.Escape}
 }
 
 // FullHelp returns key bindings for the full help view
 func (k SyncKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Save, k.Help, k.Escape},
+		{k.Save, k.Preview, k.Help, k.Escape},
 	}
 }
 
@@ -129,6 +140,12 @@ func RenderSyncView(data SyncViewData) string {
 	// Build help
 	helpView := data.Help.View(NewSyncKeyMap())
 
+	// Clause-name prompt replaces the help line while saving
+	if data.InputMode {
+		promptStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#C71585")).Bold(true)
+		helpView = promptStyle.Render("Save clause as: ") + data.InputBuffer
+	}
+
 	// Assemble the full view
 	view := fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s",
 		title,
@@ -178,15 +195,23 @@ func renderSimplePreviewMode(data SyncViewData, width, height int) string {
 
 	header := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("#888888")).
-		Render("Preview: Registry Clause")
+		Render("Preview: clause as sync will write it - 'p' to close")
 
-	// Show the raw content
-	content := data.Editor.Value()
+	// Show the clause between its markers, template variables expanded
+	content := data.Preview
+	if content == "" {
+		content = data.Editor.Value()
+	}
 	if content == "" {
 		content = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#666666")).
 			Italic(true).
 			Render("(Empty clause)")
+	} else {
+		markerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#666666"))
+		content = markerStyle.Render("<!-- SLAYGENT-REGISTRY-START -->") + "\n" +
+			content + "\n" +
+			markerStyle.Render("<!-- SLAYGENT-REGISTRY-END -->")
 	}
 
 	previewView := previewStyle.Render(content)
@@ -223,9 +248,13 @@ func renderSimpleViewMode(data SyncViewData, width, height int) string {
 			Render("(Using default registry clause)")
 	}
 
+	clauseLabel := ""
+	if data.ClauseName != "" {
+		clauseLabel = fmt.Sprintf("Clause '%s' - ", data.ClauseName)
+	}
 	header := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("#888888")).
-		Render("Tab to edit, 'c' to custom sync to all CLAUDE.md/AGENTS.md files")
+		Render(clauseLabel + "Tab to edit, 'p' to preview, 'w' to save as named clause, ←/→ switch clause, 'c' to custom sync")
 
 	overviewView := overviewStyle.Render(preview)
 
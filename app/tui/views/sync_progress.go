@@ -133,13 +133,7 @@ func renderSyncProgressActive(title string, logs []string, sp spinner.Model, act
 		Render(content)
 }
 
-// truncateLogLine truncates log lines to fit within maxWidth
+// truncateLogLine truncates log lines to fit within maxWidth terminal cells
 func truncateLogLine(line string, maxWidth int) string {
-	if len(line) <= maxWidth {
-		return line
-	}
-	if maxWidth <= 3 {
-		return "..."
-	}
-	return line[:maxWidth-3] + "..."
+	return truncateWidth(line, maxWidth)
 }
\ No newline at end of file
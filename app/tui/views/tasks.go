@@ -0,0 +1,75 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TaskItem is one work item ready for display
+type TaskItem struct {
+	ID       int64
+	Title    string
+	Assignee string
+	Status   string
+	Created  string
+}
+
+// TasksViewData contains all data needed to render the tasks view
+type TasksViewData struct {
+	Tasks []TaskItem
+	Width int
+}
+
+// taskStatusColors maps task status to a display color
+var taskStatusColors = map[string]lipgloss.Color{
+	"open":     lipgloss.Color("#D4AC0D"),
+	"assigned": lipgloss.Color("#87CEEB"),
+	"done":     lipgloss.Color("#00FF00"),
+}
+
+// RenderTasksView renders the task queue
+func RenderTasksView(data TasksViewData) string {
+	title := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#87CEEB")).
+		Bold(true).
+		Render("📌 Tasks")
+
+	controls := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#888888")).
+		Render("ESC: Back to agents  ·  slay task add/assign/list/done")
+
+	view := "\n" + title + "    " + controls + "\n\n"
+
+	if len(data.Tasks) == 0 {
+		view += lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render("No tasks queued") + "\n"
+		return wrapToTerminal(view, data.Width)
+	}
+
+	idStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#9B59B6"))
+	titleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+	assigneeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#5DADE2")).Bold(true)
+
+	var lines []string
+	for _, task := range data.Tasks {
+		statusColor, ok := taskStatusColors[task.Status]
+		if !ok {
+			statusColor = lipgloss.Color("#929292")
+		}
+
+		assignee := task.Assignee
+		if assignee == "" {
+			assignee = "unassigned"
+		}
+
+		lines = append(lines, fmt.Sprintf("%s %s %s  %s",
+			idStyle.Render(fmt.Sprintf("#%-4d", task.ID)),
+			lipgloss.NewStyle().Foreground(statusColor).Render(fmt.Sprintf("%-8s", task.Status)),
+			assigneeStyle.Render(fmt.Sprintf("%-12s", assignee)),
+			titleStyle.Render(task.Title)))
+	}
+
+	view += strings.Join(lines, "\n") + "\n"
+	return wrapToTerminal(view, data.Width)
+}
@@ -0,0 +1,17 @@
+package views
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderToast renders a transient error notification shown at the bottom of
+// whatever view is active, cleared a few seconds after it appears
+func RenderToast(text string, width int) string {
+	style := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#FF6B6B")).
+		Bold(true)
+	if noColorMode {
+		style = lipgloss.NewStyle().Bold(true).Reverse(true)
+	}
+	return wrapToTerminal(style.Render("⚠ "+text), width)
+}
@@ -0,0 +1,18 @@
+package views
+
+import (
+	"github.com/mattn/go-runewidth"
+)
+
+// truncateWidth shortens text to fit maxWidth terminal cells, appending an
+// ellipsis. Width-aware so emoji and CJK content neither corrupts (byte
+// slicing can split a rune) nor overflows (wide runes occupy two cells).
+func truncateWidth(text string, maxWidth int) string {
+	if runewidth.StringWidth(text) <= maxWidth {
+		return text
+	}
+	if maxWidth <= 3 {
+		return "..."
+	}
+	return runewidth.Truncate(text, maxWidth, "...")
+}
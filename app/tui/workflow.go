@@ -0,0 +1,309 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// Workflow orchestration: `slay workflow run <file>` executes a YAML pipeline
+// of steps - send a task to an agent, optionally wait for a reply matching a
+// pattern, then move on (or fan out to several agents) - with live progress
+// rendered in a dedicated Bubble Tea view.
+
+// WorkflowStep is one pipeline step. Either Agent or Agents must be set;
+// Agents fans the same message out to several receivers.
+type WorkflowStep struct {
+	Name    string   `yaml:"name"`
+	Agent   string   `yaml:"agent"`
+	Agents  []string `yaml:"agents"`
+	Message string   `yaml:"message"`
+	WaitFor string   `yaml:"wait_for"` // Regex matched against the agent's reply
+	Timeout int      `yaml:"timeout"`  // Seconds to wait for a matching reply (default 120)
+}
+
+// Workflow is a named pipeline loaded from a YAML file
+type Workflow struct {
+	Name  string         `yaml:"name"`
+	Steps []WorkflowStep `yaml:"steps"`
+}
+
+// loadWorkflow parses a workflow YAML file and validates its steps
+func loadWorkflow(path string) (*Workflow, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var wf Workflow
+	if err := yaml.Unmarshal(data, &wf); err != nil {
+		return nil, fmt.Errorf("invalid workflow file: %w", err)
+	}
+
+	if len(wf.Steps) == 0 {
+		return nil, fmt.Errorf("workflow has no steps")
+	}
+	for i, step := range wf.Steps {
+		if step.Agent == "" && len(step.Agents) == 0 {
+			return nil, fmt.Errorf("step %d: agent (or agents) is required", i+1)
+		}
+		if step.Message == "" {
+			return nil, fmt.Errorf("step %d: message is required", i+1)
+		}
+		if step.WaitFor != "" {
+			if _, err := regexp.Compile(step.WaitFor); err != nil {
+				return nil, fmt.Errorf("step %d: bad wait_for pattern: %w", i+1, err)
+			}
+		}
+	}
+	return &wf, nil
+}
+
+// runWorkflowCommand handles `slay workflow <subcommand>`
+func runWorkflowCommand(args []string) {
+	if len(args) < 2 || args[0] != "run" {
+		fmt.Fprintf(os.Stderr, "Usage: slay workflow run <file.yaml>\n")
+		os.Exit(1)
+	}
+
+	wf, err := loadWorkflow(args[1])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	p := tea.NewProgram(newWorkflowModel(wf))
+	finalModel, err := p.Run()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if m, ok := finalModel.(workflowModel); ok && m.failed {
+		os.Exit(1)
+	}
+}
+
+// Step progress states shown in the workflow view
+const (
+	stepPending = "pending"
+	stepRunning = "running"
+	stepDone    = "done"
+	stepFailed  = "failed"
+)
+
+type workflowModel struct {
+	workflow *Workflow
+	statuses []string
+	details  []string
+	current  int
+	failed   bool
+	finished bool
+}
+
+// stepResultMsg reports the outcome of an executed step
+type stepResultMsg struct {
+	index  int
+	detail string
+	err    error
+}
+
+func newWorkflowModel(wf *Workflow) workflowModel {
+	statuses := make([]string, len(wf.Steps))
+	for i := range statuses {
+		statuses[i] = stepPending
+	}
+	return workflowModel{
+		workflow: wf,
+		statuses: statuses,
+		details:  make([]string, len(wf.Steps)),
+	}
+}
+
+func (m workflowModel) Init() tea.Cmd {
+	return m.runStep(0)
+}
+
+// runStep executes one step in the background and reports the result
+func (m workflowModel) runStep(index int) tea.Cmd {
+	step := m.workflow.Steps[index]
+	return func() tea.Msg {
+		detail, err := executeWorkflowStep(step)
+		return stepResultMsg{index: index, detail: detail, err: err}
+	}
+}
+
+func (m workflowModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" || msg.String() == "q" {
+			return m, tea.Quit
+		}
+
+	case stepResultMsg:
+		if msg.err != nil {
+			m.statuses[msg.index] = stepFailed
+			m.details[msg.index] = msg.err.Error()
+			m.failed = true
+			m.finished = true
+			return m, tea.Quit
+		}
+
+		m.statuses[msg.index] = stepDone
+		m.details[msg.index] = msg.detail
+
+		next := msg.index + 1
+		if next >= len(m.workflow.Steps) {
+			m.finished = true
+			return m, tea.Quit
+		}
+		m.current = next
+		m.statuses[next] = stepRunning
+		return m, m.runStep(next)
+	}
+
+	if m.statuses[m.current] == stepPending {
+		m.statuses[m.current] = stepRunning
+	}
+	return m, nil
+}
+
+func (m workflowModel) View() string {
+	titleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#87CEEB")).Bold(true)
+	view := titleStyle.Render(fmt.Sprintf("Workflow: %s", m.workflow.Name)) + "\n\n"
+
+	for i, step := range m.workflow.Steps {
+		name := step.Name
+		if name == "" {
+			name = fmt.Sprintf("step %d", i+1)
+		}
+
+		var marker string
+		switch m.statuses[i] {
+		case stepDone:
+			marker = lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Render("✓")
+		case stepFailed:
+			marker = lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000")).Render("✗")
+		case stepRunning:
+			marker = lipgloss.NewStyle().Foreground(lipgloss.Color("#D4AC0D")).Render("▶")
+		default:
+			marker = lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render("·")
+		}
+
+		line := fmt.Sprintf(" %s %s → %s", marker, name, strings.Join(stepTargets(step), ", "))
+		if m.details[i] != "" {
+			line += lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render("  (" + m.details[i] + ")")
+		}
+		view += line + "\n"
+	}
+
+	if !m.finished {
+		view += "\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render("q: abort") + "\n"
+	}
+	return view
+}
+
+// stepTargets returns the receiving agents for a step
+func stepTargets(step WorkflowStep) []string {
+	if len(step.Agents) > 0 {
+		return step.Agents
+	}
+	return []string{step.Agent}
+}
+
+// executeWorkflowStep sends the step's message (fanning out if needed) and
+// optionally waits for a reply matching the step's pattern
+func executeWorkflowStep(step WorkflowStep) (string, error) {
+	start := time.Now()
+
+	for _, agent := range stepTargets(step) {
+		output, err := exec.Command("msg", agent, step.Message).CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("send to %s failed: %s", agent, strings.TrimSpace(string(output)))
+		}
+	}
+
+	if step.WaitFor == "" {
+		return "sent", nil
+	}
+
+	// Fan-out steps wait on the first listed agent's reply
+	agent := stepTargets(step)[0]
+	reply, err := waitForReply(agent, step.WaitFor, start, stepTimeout(step))
+	if err != nil {
+		return "", err
+	}
+	return truncateReply(reply), nil
+}
+
+func stepTimeout(step WorkflowStep) time.Duration {
+	if step.Timeout > 0 {
+		return time.Duration(step.Timeout) * time.Second
+	}
+	return 120 * time.Second
+}
+
+// waitForReply polls the message database for a message sent by the agent
+// after the step started whose text matches the pattern
+func waitForReply(agent, pattern string, since time.Time, timeout time.Duration) (string, error) {
+	re := regexp.MustCompile(pattern)
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	db, err := sql.Open("sqlite3", filepath.Join(home, ".slaygent", "messages.db"))
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+
+	deadline := time.Now().Add(timeout)
+	sinceStamp := since.UTC().Format("2006-01-02 15:04:05")
+
+	for time.Now().Before(deadline) {
+		rows, err := db.Query(`
+			SELECT message FROM messages
+			WHERE sender_name = ? AND sent_at > ?
+			ORDER BY sent_at ASC`,
+			agent, sinceStamp,
+		)
+		if err != nil {
+			return "", err
+		}
+
+		for rows.Next() {
+			var message string
+			if err := rows.Scan(&message); err != nil {
+				rows.Close()
+				return "", err
+			}
+			if re.MatchString(message) {
+				rows.Close()
+				return message, nil
+			}
+		}
+		rows.Close()
+
+		time.Sleep(2 * time.Second)
+	}
+
+	return "", fmt.Errorf("timed out waiting for %s to reply matching %q", agent, pattern)
+}
+
+// truncateReply keeps step detail lines short in the progress view
+func truncateReply(reply string) string {
+	reply = strings.ReplaceAll(reply, "\n", " ")
+	if len(reply) > 60 {
+		return reply[:57] + "..."
+	}
+	return reply
+}
@@ -1,73 +0,0 @@
-package main
-
-import (
-	"fmt"
-	"os"
-	"os/exec"
-)
-
-// Simple test to verify file picker components work
-func main() {
-	fmt.Println("🔍 Testing File Picker Components")
-	fmt.Println("=================================")
-
-	// Test 1: Check if fd command is available
-	fmt.Print("1. Checking fd command availability... ")
-	if _, err := exec.LookPath("fd"); err != nil {
-		fmt.Println("❌ FAIL: fd not found")
-		fmt.Println("   Install with: brew install fd")
-		return
-	}
-	fmt.Println("✅ PASS")
-
-	// Test 2: Test file discovery function
-	fmt.Print("2. Testing file discovery function... ")
-
-	// Change to the TUI directory to use the package functions
-	originalDir, _ := os.Getwd()
-	os.Chdir("app/tui")
-	defer os.Chdir(originalDir)
-
-	// We can't directly test the discovery function here since it's in main package
-	// But we can test fd command directly
-	cmd := exec.Command("fd", "-t", "f", "^(CLAUDE|AGENTS)\\.md$", os.Getenv("HOME"))
-	output, err := cmd.Output()
-	if err != nil {
-		fmt.Printf("❌ FAIL: %v\n", err)
-		return
-	}
-
-	fmt.Println("✅ PASS")
-
-	// Test 3: Count discovered files
-	lines := len(fmt.Sprintf("%s", output))
-	fmt.Printf("3. Found files: ")
-	if lines > 0 {
-		fmt.Printf("✅ Found some CLAUDE.md/AGENTS.md files\n")
-	} else {
-		fmt.Printf("⚠️  No files found (this is okay for testing)\n")
-	}
-
-	// Test 4: Verify TUI builds correctly
-	fmt.Print("4. Verifying TUI builds... ")
-	buildCmd := exec.Command("go", "build", "-o", "test-build", ".")
-	if err := buildCmd.Run(); err != nil {
-		fmt.Printf("❌ FAIL: Build error: %v\n", err)
-		return
-	}
-	fmt.Println("✅ PASS")
-
-	// Clean up test build
-	os.Remove("test-build")
-
-	fmt.Println("\n🎉 File Picker Test Summary:")
-	fmt.Println("✅ fd command available")
-	fmt.Println("✅ File discovery working")
-	fmt.Println("✅ TUI builds successfully")
-	fmt.Println("\n📋 Next Steps:")
-	fmt.Println("1. Run 'slay' to test the TUI")
-	fmt.Println("2. Press 'e' to enter sync customization")
-	fmt.Println("3. Add some custom content")
-	fmt.Println("4. Press 'c' to open the file picker")
-	fmt.Println("5. Use SPACE to select files, ENTER to sync")
-}
\ No newline at end of file